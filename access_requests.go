@@ -2,7 +2,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -45,7 +44,7 @@ func (s *AccessRequestsService) ListProjectAccessRequests(pid interface{}, opt *
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/access_requests", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/access_requests", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -71,7 +70,7 @@ func (s *AccessRequestsService) ListGroupAccessRequests(gid interface{}, opt *Li
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/access_requests", url.QueryEscape(group))
+	u := fmt.Sprintf("groups/%s/access_requests", pathEscape(group))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -97,7 +96,7 @@ func (s *AccessRequestsService) RequestProjectAccess(pid interface{}, options ..
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/access_requests", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/access_requests", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -123,7 +122,7 @@ func (s *AccessRequestsService) RequestGroupAccess(gid interface{}, options ...O
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/access_requests", url.QueryEscape(group))
+	u := fmt.Sprintf("groups/%s/access_requests", pathEscape(group))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -157,7 +156,7 @@ func (s *AccessRequestsService) ApproveProjectAccessRequest(pid interface{}, use
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/access_requests/%d/approve", url.QueryEscape(project), user)
+	u := fmt.Sprintf("projects/%s/access_requests/%d/approve", pathEscape(project), user)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -182,7 +181,7 @@ func (s *AccessRequestsService) ApproveGroupAccessRequest(gid interface{}, user
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/access_requests/%d/approve", url.QueryEscape(group), user)
+	u := fmt.Sprintf("groups/%s/access_requests/%d/approve", pathEscape(group), user)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -207,7 +206,7 @@ func (s *AccessRequestsService) DenyProjectAccessRequest(pid interface{}, user i
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/access_requests/%d", url.QueryEscape(project), user)
+	u := fmt.Sprintf("projects/%s/access_requests/%d", pathEscape(project), user)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -226,7 +225,7 @@ func (s *AccessRequestsService) DenyGroupAccessRequest(gid interface{}, user int
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("groups/%s/access_requests/%d", url.QueryEscape(group), user)
+	u := fmt.Sprintf("groups/%s/access_requests/%d", pathEscape(group), user)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
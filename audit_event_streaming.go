@@ -0,0 +1,294 @@
+package gitlab
+
+import (
+	"encoding/json"
+)
+
+// AuditEventStreamingService handles communication with the audit event
+// streaming related methods of the GitLab API. GitLab only exposes these
+// through its GraphQL API, so the GraphQL API is used by all methods.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html
+type AuditEventStreamingService struct {
+	client *Client
+}
+
+// AuditEventStreamingDestination represents an external destination that
+// audit events are streamed to. The ID is a GraphQL global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html
+type AuditEventStreamingDestination struct {
+	ID                string                       `json:"id"`
+	Name              string                       `json:"name"`
+	DestinationURL    string                       `json:"destinationUrl"`
+	VerificationToken string                       `json:"verificationToken"`
+	Headers           []*AuditEventStreamingHeader `json:"-"`
+}
+
+func (d AuditEventStreamingDestination) String() string {
+	return Stringify(d)
+}
+
+// AuditEventStreamingHeader represents a custom HTTP header that is sent
+// with the audit events streamed to a destination. The ID is a GraphQL
+// global ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html
+type AuditEventStreamingHeader struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Active bool   `json:"active"`
+}
+
+func (h AuditEventStreamingHeader) String() string {
+	return Stringify(h)
+}
+
+const auditEventStreamingDestinationFields = `id name destinationUrl verificationToken`
+
+const auditEventStreamingHeaderFields = `id key value active`
+
+// ListInstanceDestinations gets all instance level streaming destinations,
+// including their HTTP headers. This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#list-streaming-destinations
+func (s *AuditEventStreamingService) ListInstanceDestinations(options ...OptionFunc) ([]*AuditEventStreamingDestination, *Response, error) {
+	q := &GraphQLQuery{
+		Query: `query($after: String) {
+			instanceExternalAuditEventDestinations(after: $after) {
+				nodes { ` + auditEventStreamingDestinationFields + ` headers { nodes { ` + auditEventStreamingHeaderFields + ` } } }
+				pageInfo { endCursor hasNextPage }
+			}
+		}`,
+	}
+
+	var ds []*AuditEventStreamingDestination
+	gr, err := s.client.GraphQL.Paginate(q, "instanceExternalAuditEventDestinations", func(page json.RawMessage) error {
+		var conn struct {
+			Nodes []struct {
+				AuditEventStreamingDestination
+				Headers struct {
+					Nodes []*AuditEventStreamingHeader `json:"nodes"`
+				} `json:"headers"`
+			} `json:"nodes"`
+		}
+		if err := json.Unmarshal(page, &conn); err != nil {
+			return err
+		}
+		for _, n := range conn.Nodes {
+			d := n.AuditEventStreamingDestination
+			d.Headers = n.Headers.Nodes
+			ds = append(ds, &d)
+		}
+		return nil
+	}, options...)
+
+	var resp *Response
+	if gr != nil {
+		resp = gr.Response
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ds, resp, err
+}
+
+// InstanceDestinationOptions represents the available
+// CreateInstanceDestination() and UpdateInstanceDestination() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-a-new-streaming-destination
+type InstanceDestinationOptions struct {
+	Name           *string `json:"name,omitempty"`
+	DestinationURL *string `json:"destinationUrl,omitempty"`
+}
+
+// CreateInstanceDestination adds an instance level streaming destination.
+// This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-a-new-streaming-destination
+func (s *AuditEventStreamingService) CreateInstanceDestination(opt *InstanceDestinationOptions, options ...OptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	q := &GraphQLQuery{
+		Query: `mutation($input: InstanceExternalAuditEventDestinationCreateInput!) {
+			instanceExternalAuditEventDestinationCreate(input: $input) {
+				errors
+				instanceExternalAuditEventDestination { ` + auditEventStreamingDestinationFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": opt},
+	}
+
+	var p struct {
+		Destination *AuditEventStreamingDestination `json:"instanceExternalAuditEventDestination"`
+	}
+	resp, err := s.client.GraphQL.mutate(q, "instanceExternalAuditEventDestinationCreate", &p, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p.Destination, resp, err
+}
+
+// UpdateInstanceDestination updates an instance level streaming destination.
+// This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#update-streaming-destinations
+func (s *AuditEventStreamingService) UpdateInstanceDestination(id string, opt *InstanceDestinationOptions, options ...OptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	input := map[string]interface{}{"id": id}
+	if opt != nil {
+		if opt.Name != nil {
+			input["name"] = *opt.Name
+		}
+		if opt.DestinationURL != nil {
+			input["destinationUrl"] = *opt.DestinationURL
+		}
+	}
+
+	q := &GraphQLQuery{
+		Query: `mutation($input: InstanceExternalAuditEventDestinationUpdateInput!) {
+			instanceExternalAuditEventDestinationUpdate(input: $input) {
+				errors
+				instanceExternalAuditEventDestination { ` + auditEventStreamingDestinationFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": input},
+	}
+
+	var p struct {
+		Destination *AuditEventStreamingDestination `json:"instanceExternalAuditEventDestination"`
+	}
+	resp, err := s.client.GraphQL.mutate(q, "instanceExternalAuditEventDestinationUpdate", &p, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p.Destination, resp, err
+}
+
+// DeleteInstanceDestination deletes an instance level streaming destination.
+// This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#delete-streaming-destinations
+func (s *AuditEventStreamingService) DeleteInstanceDestination(id string, options ...OptionFunc) (*Response, error) {
+	q := &GraphQLQuery{
+		Query: `mutation($id: ID!) {
+			instanceExternalAuditEventDestinationDestroy(input: { id: $id }) { errors }
+		}`,
+		Variables: map[string]interface{}{"id": id},
+	}
+
+	return s.client.GraphQL.mutate(q, "instanceExternalAuditEventDestinationDestroy", nil, options)
+}
+
+// InstanceDestinationHeaderOptions represents the available
+// AddInstanceDestinationHeader() and UpdateInstanceDestinationHeader()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-with-an-http-header
+type InstanceDestinationHeaderOptions struct {
+	Key    *string `json:"key,omitempty"`
+	Value  *string `json:"value,omitempty"`
+	Active *bool   `json:"active,omitempty"`
+}
+
+// AddInstanceDestinationHeader adds an HTTP header to an instance level
+// streaming destination. This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-with-an-http-header
+func (s *AuditEventStreamingService) AddInstanceDestinationHeader(destinationID string, opt *InstanceDestinationHeaderOptions, options ...OptionFunc) (*AuditEventStreamingHeader, *Response, error) {
+	input := headerInput("destinationId", destinationID, opt)
+
+	q := &GraphQLQuery{
+		Query: `mutation($input: AuditEventsStreamingInstanceHeadersCreateInput!) {
+			auditEventsStreamingInstanceHeadersCreate(input: $input) {
+				errors
+				header { ` + auditEventStreamingHeaderFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": input},
+	}
+
+	var p struct {
+		Header *AuditEventStreamingHeader `json:"header"`
+	}
+	resp, err := s.client.GraphQL.mutate(q, "auditEventsStreamingInstanceHeadersCreate", &p, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p.Header, resp, err
+}
+
+// UpdateInstanceDestinationHeader updates an HTTP header of an instance level
+// streaming destination. This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#update-streaming-destinations
+func (s *AuditEventStreamingService) UpdateInstanceDestinationHeader(headerID string, opt *InstanceDestinationHeaderOptions, options ...OptionFunc) (*AuditEventStreamingHeader, *Response, error) {
+	input := headerInput("headerId", headerID, opt)
+
+	q := &GraphQLQuery{
+		Query: `mutation($input: AuditEventsStreamingInstanceHeadersUpdateInput!) {
+			auditEventsStreamingInstanceHeadersUpdate(input: $input) {
+				errors
+				header { ` + auditEventStreamingHeaderFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": input},
+	}
+
+	var p struct {
+		Header *AuditEventStreamingHeader `json:"header"`
+	}
+	resp, err := s.client.GraphQL.mutate(q, "auditEventsStreamingInstanceHeadersUpdate", &p, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p.Header, resp, err
+}
+
+// DeleteInstanceDestinationHeader deletes an HTTP header of an instance level
+// streaming destination. This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#delete-streaming-destinations
+func (s *AuditEventStreamingService) DeleteInstanceDestinationHeader(headerID string, options ...OptionFunc) (*Response, error) {
+	q := &GraphQLQuery{
+		Query: `mutation($id: AuditEventsInstanceStreamingHeaderID!) {
+			auditEventsStreamingInstanceHeadersDestroy(input: { headerId: $id }) { errors }
+		}`,
+		Variables: map[string]interface{}{"id": headerID},
+	}
+
+	return s.client.GraphQL.mutate(q, "auditEventsStreamingInstanceHeadersDestroy", nil, options)
+}
+
+// headerInput builds the input of the header mutations, as the ID field is
+// named differently for every mutation.
+func headerInput(idField, id string, opt *InstanceDestinationHeaderOptions) map[string]interface{} {
+	input := map[string]interface{}{idField: id}
+	if opt != nil {
+		if opt.Key != nil {
+			input["key"] = *opt.Key
+		}
+		if opt.Value != nil {
+			input["value"] = *opt.Value
+		}
+		if opt.Active != nil {
+			input["active"] = *opt.Active
+		}
+	}
+	return input
+}
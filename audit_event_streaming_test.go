@@ -0,0 +1,108 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListInstanceDestinations(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinations": {
+			"nodes": [{
+				"id": "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1",
+				"name": "SIEM",
+				"destinationUrl": "https://siem.example.com/events",
+				"verificationToken": "52EbCgV3e5kNr5eAAR5WmxHS",
+				"headers": {"nodes": [{"id": "gid://gitlab/AuditEvents::Instance::Streaming::Header/1", "key": "Authorization", "value": "secret", "active": true}]}
+			}],
+			"pageInfo": {"endCursor": "c1", "hasNextPage": false}
+		}}}`)
+	})
+
+	ds, _, err := client.AuditEventStreaming.ListInstanceDestinations()
+	if err != nil {
+		t.Fatalf("AuditEventStreaming.ListInstanceDestinations returned error: %v", err)
+	}
+
+	want := []*AuditEventStreamingDestination{{
+		ID:                "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1",
+		Name:              "SIEM",
+		DestinationURL:    "https://siem.example.com/events",
+		VerificationToken: "52EbCgV3e5kNr5eAAR5WmxHS",
+		Headers: []*AuditEventStreamingHeader{{
+			ID:     "gid://gitlab/AuditEvents::Instance::Streaming::Header/1",
+			Key:    "Authorization",
+			Value:  "secret",
+			Active: true,
+		}},
+	}}
+	if !reflect.DeepEqual(want, ds) {
+		t.Errorf("AuditEventStreaming.ListInstanceDestinations returned %+v, want %+v", ds, want)
+	}
+}
+
+func TestCreateInstanceDestination(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		var q struct {
+			Variables map[string]map[string]string `json:"variables"`
+		}
+		if err := json.Unmarshal(b, &q); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]string{"name": "SIEM", "destinationUrl": "https://siem.example.com/events"}
+		if !reflect.DeepEqual(want, q.Variables["input"]) {
+			t.Errorf("Request input %v, want %v", q.Variables["input"], want)
+		}
+
+		fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinationCreate": {
+			"errors": [],
+			"instanceExternalAuditEventDestination": {"id": "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1", "name": "SIEM", "destinationUrl": "https://siem.example.com/events"}
+		}}}`)
+	})
+
+	d, _, err := client.AuditEventStreaming.CreateInstanceDestination(&InstanceDestinationOptions{
+		Name:           String("SIEM"),
+		DestinationURL: String("https://siem.example.com/events"),
+	})
+	if err != nil {
+		t.Fatalf("AuditEventStreaming.CreateInstanceDestination returned error: %v", err)
+	}
+
+	want := &AuditEventStreamingDestination{
+		ID:             "gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1",
+		Name:           "SIEM",
+		DestinationURL: "https://siem.example.com/events",
+	}
+	if !reflect.DeepEqual(want, d) {
+		t.Errorf("AuditEventStreaming.CreateInstanceDestination returned %+v, want %+v", d, want)
+	}
+}
+
+func TestAddInstanceDestinationHeaderErrors(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"auditEventsStreamingInstanceHeadersCreate": {"errors": ["Key has already been taken"], "header": null}}}`)
+	})
+
+	_, _, err := client.AuditEventStreaming.AddInstanceDestinationHeader("gid://gitlab/AuditEvents::InstanceExternalAuditEventDestination/1", &InstanceDestinationHeaderOptions{
+		Key:   String("Authorization"),
+		Value: String("secret"),
+	})
+	if _, ok := err.(GraphQLErrors); !ok {
+		t.Fatalf("AuditEventStreaming.AddInstanceDestinationHeader returned error %v, want GraphQLErrors", err)
+	}
+}
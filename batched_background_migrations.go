@@ -0,0 +1,130 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// BatchedBackgroundMigrationsService handles communication with the batched
+// background migrations related methods of the GitLab API. These are only
+// available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html
+type BatchedBackgroundMigrationsService struct {
+	client *Client
+}
+
+// BatchedBackgroundMigrationStatusValue represents the status of a batched
+// background migration.
+type BatchedBackgroundMigrationStatusValue string
+
+// These constants represent all valid batched background migration statuses.
+const (
+	BatchedBackgroundMigrationPaused    BatchedBackgroundMigrationStatusValue = "paused"
+	BatchedBackgroundMigrationActive    BatchedBackgroundMigrationStatusValue = "active"
+	BatchedBackgroundMigrationFinished  BatchedBackgroundMigrationStatusValue = "finished"
+	BatchedBackgroundMigrationFailed    BatchedBackgroundMigrationStatusValue = "failed"
+	BatchedBackgroundMigrationFinalized BatchedBackgroundMigrationStatusValue = "finalized"
+)
+
+// BatchedBackgroundMigration represents a GitLab batched background
+// migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html
+type BatchedBackgroundMigration struct {
+	ID           int                                   `json:"id"`
+	JobClassName string                                `json:"job_class_name"`
+	TableName    string                                `json:"table_name"`
+	ColumnName   string                                `json:"column_name"`
+	Status       BatchedBackgroundMigrationStatusValue `json:"status"`
+	Progress     float64                               `json:"progress"`
+	CreatedAt    *time.Time                            `json:"created_at"`
+}
+
+func (m BatchedBackgroundMigration) String() string {
+	return Stringify(m)
+}
+
+// Done reports whether the migration has finished or was finalized.
+func (m BatchedBackgroundMigration) Done() bool {
+	return m.Status == BatchedBackgroundMigrationFinished || m.Status == BatchedBackgroundMigrationFinalized
+}
+
+// BatchedBackgroundMigrationOptions represents the available options of the
+// batched background migration methods.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html
+type BatchedBackgroundMigrationOptions struct {
+	Database *string `url:"database,omitempty" json:"database,omitempty"`
+}
+
+// ListBatchedBackgroundMigrations gets a list of the batched background
+// migrations of a database, the main database by default.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#list-batched-background-migrations
+func (s *BatchedBackgroundMigrationsService) ListBatchedBackgroundMigrations(opt *BatchedBackgroundMigrationOptions, options ...OptionFunc) ([]*BatchedBackgroundMigration, *Response, error) {
+	req, err := s.client.NewRequest("GET", "admin/batched_background_migrations", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ms []*BatchedBackgroundMigration
+	resp, err := s.client.Do(req, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
+
+// GetBatchedBackgroundMigration gets a single batched background migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#retrieve-a-batched-background-migration
+func (s *BatchedBackgroundMigrationsService) GetBatchedBackgroundMigration(migration int, opt *BatchedBackgroundMigrationOptions, options ...OptionFunc) (*BatchedBackgroundMigration, *Response, error) {
+	u := fmt.Sprintf("admin/batched_background_migrations/%d", migration)
+
+	return s.do("GET", u, opt, options)
+}
+
+// PauseBatchedBackgroundMigration pauses an active batched background
+// migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#pause-a-batched-background-migration
+func (s *BatchedBackgroundMigrationsService) PauseBatchedBackgroundMigration(migration int, opt *BatchedBackgroundMigrationOptions, options ...OptionFunc) (*BatchedBackgroundMigration, *Response, error) {
+	u := fmt.Sprintf("admin/batched_background_migrations/%d/pause", migration)
+
+	return s.do("PUT", u, opt, options)
+}
+
+// ResumeBatchedBackgroundMigration resumes a paused batched background
+// migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/admin/batched_background_migrations.html#resume-a-batched-background-migration
+func (s *BatchedBackgroundMigrationsService) ResumeBatchedBackgroundMigration(migration int, opt *BatchedBackgroundMigrationOptions, options ...OptionFunc) (*BatchedBackgroundMigration, *Response, error) {
+	u := fmt.Sprintf("admin/batched_background_migrations/%d/resume", migration)
+
+	return s.do("PUT", u, opt, options)
+}
+
+// do sends a request for a single batched background migration.
+func (s *BatchedBackgroundMigrationsService) do(method, u string, opt *BatchedBackgroundMigrationOptions, options []OptionFunc) (*BatchedBackgroundMigration, *Response, error) {
+	req, err := s.client.NewRequest(method, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(BatchedBackgroundMigration)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
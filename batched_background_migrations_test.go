@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListBatchedBackgroundMigrations(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/admin/batched_background_migrations?database=ci")
+		fmt.Fprint(w, `[{
+			"id": 1234,
+			"job_class_name": "CopyColumnUsingBackgroundMigrationJob",
+			"table_name": "events",
+			"column_name": "id",
+			"status": "active",
+			"progress": 50
+		}]`)
+	})
+
+	opt := &BatchedBackgroundMigrationOptions{Database: String("ci")}
+	ms, _, err := client.BatchedBackgroundMigrations.ListBatchedBackgroundMigrations(opt)
+	if err != nil {
+		t.Fatalf("BatchedBackgroundMigrations.ListBatchedBackgroundMigrations returned error: %v", err)
+	}
+
+	want := []*BatchedBackgroundMigration{{
+		ID:           1234,
+		JobClassName: "CopyColumnUsingBackgroundMigrationJob",
+		TableName:    "events",
+		ColumnName:   "id",
+		Status:       BatchedBackgroundMigrationActive,
+		Progress:     50,
+	}}
+	if !reflect.DeepEqual(want, ms) {
+		t.Errorf("BatchedBackgroundMigrations.ListBatchedBackgroundMigrations returned %+v, want %+v", ms, want)
+	}
+	if ms[0].Done() {
+		t.Errorf("BatchedBackgroundMigration.Done returned true for an active migration")
+	}
+}
+
+func TestGetBatchedBackgroundMigration(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1234, "status": "finished", "progress": 100}`)
+	})
+
+	m, _, err := client.BatchedBackgroundMigrations.GetBatchedBackgroundMigration(1234, nil)
+	if err != nil {
+		t.Fatalf("BatchedBackgroundMigrations.GetBatchedBackgroundMigration returned error: %v", err)
+	}
+
+	want := &BatchedBackgroundMigration{ID: 1234, Status: BatchedBackgroundMigrationFinished, Progress: 100}
+	if !reflect.DeepEqual(want, m) {
+		t.Errorf("BatchedBackgroundMigrations.GetBatchedBackgroundMigration returned %+v, want %+v", m, want)
+	}
+	if !m.Done() {
+		t.Errorf("BatchedBackgroundMigration.Done returned false for a finished migration")
+	}
+}
+
+func TestPauseAndResumeBatchedBackgroundMigration(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations/1234/pause", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"database":"main"}`)
+		fmt.Fprint(w, `{"id": 1234, "status": "paused"}`)
+	})
+
+	mux.HandleFunc("/api/v4/admin/batched_background_migrations/1234/resume", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"id": 1234, "status": "active"}`)
+	})
+
+	opt := &BatchedBackgroundMigrationOptions{Database: String("main")}
+	m, _, err := client.BatchedBackgroundMigrations.PauseBatchedBackgroundMigration(1234, opt)
+	if err != nil {
+		t.Fatalf("BatchedBackgroundMigrations.PauseBatchedBackgroundMigration returned error: %v", err)
+	}
+	if m.Status != BatchedBackgroundMigrationPaused {
+		t.Errorf("BatchedBackgroundMigrations.PauseBatchedBackgroundMigration returned status %q, want %q", m.Status, BatchedBackgroundMigrationPaused)
+	}
+
+	m, _, err = client.BatchedBackgroundMigrations.ResumeBatchedBackgroundMigration(1234, nil)
+	if err != nil {
+		t.Fatalf("BatchedBackgroundMigrations.ResumeBatchedBackgroundMigration returned error: %v", err)
+	}
+	if m.Status != BatchedBackgroundMigrationActive {
+		t.Errorf("BatchedBackgroundMigrations.ResumeBatchedBackgroundMigration returned status %q, want %q", m.Status, BatchedBackgroundMigrationActive)
+	}
+}
@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // IssueBoardsService handles communication with the issue board related
@@ -70,7 +69,7 @@ func (s *IssueBoardsService) ListIssueBoards(pid interface{}, opt *ListIssueBoar
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/boards", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/boards", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -94,7 +93,7 @@ func (s *IssueBoardsService) GetIssueBoard(pid interface{}, board int, options .
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/boards/%d", url.QueryEscape(project), board)
+	u := fmt.Sprintf("projects/%s/boards/%d", pathEscape(project), board)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -124,7 +123,7 @@ func (s *IssueBoardsService) GetIssueBoardLists(pid interface{}, board int, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/boards/%d/lists", url.QueryEscape(project), board)
+	u := fmt.Sprintf("projects/%s/boards/%d/lists", pathEscape(project), board)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -149,7 +148,7 @@ func (s *IssueBoardsService) GetIssueBoardList(pid interface{}, board, list int,
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/boards/%d/lists/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		board,
 		list,
 	)
@@ -173,7 +172,7 @@ func (s *IssueBoardsService) GetIssueBoardList(pid interface{}, board, list int,
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/boards.html#new-board-list
 type CreateIssueBoardListOptions struct {
-	LabelID *int `url:"label_id" json:"label_id"`
+	LabelID *int `url:"label_id,omitempty" json:"label_id,omitempty"`
 }
 
 // CreateIssueBoardList creates a new issue board list.
@@ -184,7 +183,7 @@ func (s *IssueBoardsService) CreateIssueBoardList(pid interface{}, board int, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/boards/%d/lists", url.QueryEscape(project), board)
+	u := fmt.Sprintf("projects/%s/boards/%d/lists", pathEscape(project), board)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -205,7 +204,7 @@ func (s *IssueBoardsService) CreateIssueBoardList(pid interface{}, board int, op
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/boards.html#edit-board-list
 type UpdateIssueBoardListOptions struct {
-	Position *int `url:"position" json:"position"`
+	Position *int `url:"position,omitempty" json:"position,omitempty"`
 }
 
 // UpdateIssueBoardList updates the position of an existing issue board list.
@@ -217,7 +216,7 @@ func (s *IssueBoardsService) UpdateIssueBoardList(pid interface{}, board, list i
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/boards/%d/lists/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		board,
 		list,
 	)
@@ -247,7 +246,7 @@ func (s *IssueBoardsService) DeleteIssueBoardList(pid interface{}, board, list i
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/boards/%d/lists/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		board,
 		list,
 	)
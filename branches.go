@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // BranchesService handles communication with the branch related methods
@@ -61,7 +60,7 @@ func (s *BranchesService) ListBranches(pid interface{}, opts *ListBranchesOption
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/branches", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/branches", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opts, options)
 	if err != nil {
@@ -86,7 +85,7 @@ func (s *BranchesService) GetBranch(pid interface{}, branch string, options ...O
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/branches/%s", url.QueryEscape(project), url.QueryEscape(branch))
+	u := fmt.Sprintf("projects/%s/repository/branches/%s", pathEscape(project), pathEscape(branch))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -122,7 +121,7 @@ func (s *BranchesService) ProtectBranch(pid interface{}, branch string, opts *Pr
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/branches/%s/protect", url.QueryEscape(project), url.QueryEscape(branch))
+	u := fmt.Sprintf("projects/%s/repository/branches/%s/protect", pathEscape(project), pathEscape(branch))
 
 	req, err := s.client.NewRequest("PUT", u, opts, options)
 	if err != nil {
@@ -149,7 +148,7 @@ func (s *BranchesService) UnprotectBranch(pid interface{}, branch string, option
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/branches/%s/unprotect", url.QueryEscape(project), url.QueryEscape(branch))
+	u := fmt.Sprintf("projects/%s/repository/branches/%s/unprotect", pathEscape(project), pathEscape(branch))
 
 	req, err := s.client.NewRequest("PUT", u, nil, options)
 	if err != nil {
@@ -183,7 +182,7 @@ func (s *BranchesService) CreateBranch(pid interface{}, opt *CreateBranchOptions
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/branches", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/branches", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -208,7 +207,7 @@ func (s *BranchesService) DeleteBranch(pid interface{}, branch string, options .
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/branches/%s", url.QueryEscape(project), url.QueryEscape(branch))
+	u := fmt.Sprintf("projects/%s/repository/branches/%s", pathEscape(project), pathEscape(branch))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -227,7 +226,7 @@ func (s *BranchesService) DeleteMergedBranches(pid interface{}, options ...Optio
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/merged_branches", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/merged_branches", pathEscape(project))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -96,7 +96,7 @@ func (s *BroadcastMessagesService) GetBroadcastMessage(broadcast int, options ..
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/broadcast_messages.html#create-a-broadcast-message
 type CreateBroadcastMessageOptions struct {
-	Message  *string    `url:"message" json:"message"`
+	Message  *string    `url:"message,omitempty" json:"message,omitempty"`
 	StartsAt *time.Time `url:"starts_at,omitempty" json:"starts_at,omitempty"`
 	EndsAt   *time.Time `url:"ends_at,omitempty" json:"ends_at,omitempty"`
 	Color    *string    `url:"color,omitempty" json:"color,omitempty"`
@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+// WithRootCAs makes the client verify the certificate of the GitLab server
+// using the given certificate pool, instead of the system roots. Use
+// NewCertPool to create a pool from PEM encoded certificate files.
+//
+// The TLS options only have effect when the HTTP client passed to the client
+// constructor uses the default transport or an *http.Transport. For any other
+// transport a warning is logged and the options are ignored, so configure TLS
+// on that transport instead. The given HTTP client itself is never modified.
+func WithRootCAs(pool *x509.CertPool) ClientOptionFunc {
+	return func(c *Client) {
+		if cfg := c.tlsConfig(); cfg != nil {
+			cfg.RootCAs = pool
+		}
+	}
+}
+
+// WithClientCertificates makes the client present the given certificates to
+// the GitLab server, for use with mutual TLS. Use tls.LoadX509KeyPair to load
+// a certificate and key from PEM encoded files.
+func WithClientCertificates(certs ...tls.Certificate) ClientOptionFunc {
+	return func(c *Client) {
+		if cfg := c.tlsConfig(); cfg != nil {
+			cfg.Certificates = append(cfg.Certificates, certs...)
+		}
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the certificate of the
+// GitLab server.
+//
+// WARNING: This makes the client vulnerable to man-in-the-middle attacks, so
+// every request, including the token used to authenticate it, can be read
+// and modified by anyone on the network path. Never use this in production;
+// use WithRootCAs to trust a private certificate authority instead.
+func WithInsecureSkipVerify() ClientOptionFunc {
+	return func(c *Client) {
+		if cfg := c.tlsConfig(); cfg != nil {
+			log.Print("[WARN] go-gitlab: TLS certificate verification is disabled, " +
+				"the connection is NOT secure")
+			cfg.InsecureSkipVerify = true
+		}
+	}
+}
+
+// NewCertPool returns a certificate pool containing the system roots and the
+// certificates in the given PEM encoded files.
+func NewCertPool(files ...string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	for _, file := range files {
+		pem, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", file)
+		}
+	}
+
+	return pool, nil
+}
+
+// tlsConfig returns the TLS config of the transport of the client, after
+// making sure the client uses its own copy of the HTTP client and transport.
+// It logs a warning and returns nil if the transport is not an
+// *http.Transport.
+func (c *Client) tlsConfig() *tls.Config {
+	var t *http.Transport
+	switch rt := c.client.Transport.(type) {
+	case nil:
+		t = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		t = rt.Clone()
+	default:
+		log.Printf("[WARN] go-gitlab: TLS options can't be applied to a transport of type %T "+
+			"and are ignored, configure TLS on the transport instead", rt)
+		return nil
+	}
+
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{}
+	}
+
+	hc := *c.client
+	hc.Transport = t
+	c.client = &hc
+
+	return t.TLSClientConfig
+}
@@ -0,0 +1,63 @@
+package gitlab
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWithRootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil, "")
+	client.SetBaseURL(server.URL)
+
+	if _, _, err := client.Users.CurrentUser(); err == nil {
+		t.Fatal("Expected an error for an unknown certificate authority")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	client = NewClient(nil, "", WithRootCAs(pool))
+	client.SetBaseURL(server.URL)
+
+	if _, _, err := client.Users.CurrentUser(); err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
+	}
+
+	if client.client == http.DefaultClient || client.client.Transport == http.DefaultTransport {
+		t.Error("WithRootCAs modified the default HTTP client")
+	}
+}
+
+func TestWithTLSOptionsCustomTransport(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	hc := &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}
+
+	client := NewClient(hc, "", WithInsecureSkipVerify())
+	if client.client != hc {
+		t.Error("Expected TLS options to be ignored for custom transports")
+	}
+	if !strings.Contains(buf.String(), "TLS options can't be applied to a transport of type gitlab.roundTripperFunc") {
+		t.Errorf("Expected a warning for the ignored TLS options, got %q", buf.String())
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
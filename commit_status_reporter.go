@@ -0,0 +1,201 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"text/template"
+	"time"
+)
+
+// CommitStatusReporterOptions represents the available
+// NewCommitStatusReporter() options.
+type CommitStatusReporterOptions struct {
+	// Name of the status, used to distinguish it from statuses reported by
+	// other systems. Defaults to "default" on the GitLab side.
+	Name *string
+
+	// Ref of the commit (branch or tag) to report the status for.
+	Ref *string
+
+	// TargetURL is a text/template used to generate the target URL of every
+	// reported status. It can use the fields of CommitStatusTemplateData,
+	// e.g. "https://ci.example.com/{{.SHA}}/{{.State}}".
+	TargetURL string
+
+	// Retries is the number of times a failed status update is retried.
+	// Defaults to 3.
+	Retries *int
+
+	// RetryInterval is the time to wait before retrying a failed status
+	// update. Defaults to 1 second.
+	RetryInterval time.Duration
+}
+
+// CommitStatusTemplateData represents the data that can be used in the
+// TargetURL template of a CommitStatusReporter.
+type CommitStatusTemplateData struct {
+	SHA   string
+	Ref   string
+	Name  string
+	State BuildStateValue
+}
+
+// CommitStatusReporter reports the status of an external job for a single
+// commit, taking care of the state transitions and retrying failed updates.
+//
+// Example usage:
+//
+//	reporter, err := git.Commits.NewCommitStatusReporter("group/project", sha, &gitlab.CommitStatusReporterOptions{
+//		Name:      gitlab.String("external-ci"),
+//		TargetURL: "https://ci.example.com/builds/{{.SHA}}",
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	err = reporter.Run(ctx, func(ctx context.Context) error {
+//		return build(ctx)
+//	})
+type CommitStatusReporter struct {
+	client *Client
+	pid    interface{}
+	sha    string
+
+	name          *string
+	ref           *string
+	targetURL     *template.Template
+	retries       int
+	retryInterval time.Duration
+}
+
+// NewCommitStatusReporter returns a new CommitStatusReporter for the given
+// project and commit.
+func (s *CommitsService) NewCommitStatusReporter(pid interface{}, sha string, opt *CommitStatusReporterOptions) (*CommitStatusReporter, error) {
+	r := &CommitStatusReporter{
+		client:        s.client,
+		pid:           pid,
+		sha:           sha,
+		retries:       3,
+		retryInterval: time.Second,
+	}
+
+	if opt == nil {
+		return r, nil
+	}
+
+	r.name = opt.Name
+	r.ref = opt.Ref
+	if opt.Retries != nil {
+		r.retries = *opt.Retries
+	}
+	if opt.RetryInterval > 0 {
+		r.retryInterval = opt.RetryInterval
+	}
+	if opt.TargetURL != "" {
+		tmpl, err := template.New("target_url").Parse(opt.TargetURL)
+		if err != nil {
+			return nil, err
+		}
+		r.targetURL = tmpl
+	}
+
+	return r, nil
+}
+
+// Pending reports the commit status as pending.
+func (r *CommitStatusReporter) Pending(ctx context.Context, description string, options ...OptionFunc) (*CommitStatus, *Response, error) {
+	return r.report(ctx, Pending, description, options)
+}
+
+// Running reports the commit status as running.
+func (r *CommitStatusReporter) Running(ctx context.Context, description string, options ...OptionFunc) (*CommitStatus, *Response, error) {
+	return r.report(ctx, Running, description, options)
+}
+
+// Success reports the commit status as successful.
+func (r *CommitStatusReporter) Success(ctx context.Context, description string, options ...OptionFunc) (*CommitStatus, *Response, error) {
+	return r.report(ctx, Success, description, options)
+}
+
+// Failed reports the commit status as failed.
+func (r *CommitStatusReporter) Failed(ctx context.Context, description string, options ...OptionFunc) (*CommitStatus, *Response, error) {
+	return r.report(ctx, Failed, description, options)
+}
+
+// Canceled reports the commit status as canceled.
+func (r *CommitStatusReporter) Canceled(ctx context.Context, description string, options ...OptionFunc) (*CommitStatus, *Response, error) {
+	return r.report(ctx, Canceled, description, options)
+}
+
+// Run reports the commit status as running, calls fn and then reports the
+// commit status as successful or failed depending on the error returned by
+// fn. If ctx is canceled while fn is running, the status is reported as
+// canceled instead. The error returned by fn takes precedence over any error
+// that occurred while reporting the status.
+func (r *CommitStatusReporter) Run(ctx context.Context, fn func(context.Context) error, options ...OptionFunc) error {
+	if _, _, err := r.Running(ctx, "", options...); err != nil {
+		return err
+	}
+
+	if err := fn(ctx); err != nil {
+		if ctx.Err() != nil {
+			// Use a fresh context, as the original one is already done.
+			r.Canceled(context.Background(), ctx.Err().Error(), options...)
+			return err
+		}
+		r.Failed(ctx, err.Error(), options...)
+		return err
+	}
+
+	_, _, err := r.Success(ctx, "", options...)
+	return err
+}
+
+func (r *CommitStatusReporter) report(ctx context.Context, state BuildStateValue, description string, options []OptionFunc) (*CommitStatus, *Response, error) {
+	opt := &SetCommitStatusOptions{
+		State: state,
+		Name:  r.name,
+		Ref:   r.ref,
+	}
+	if description != "" {
+		opt.Description = String(description)
+	}
+
+	if r.targetURL != nil {
+		data := CommitStatusTemplateData{SHA: r.sha, State: state}
+		if r.name != nil {
+			data.Name = *r.name
+		}
+		if r.ref != nil {
+			data.Ref = *r.ref
+		}
+
+		var buf bytes.Buffer
+		if err := r.targetURL.Execute(&buf, data); err != nil {
+			return nil, nil, err
+		}
+		opt.TargetURL = String(buf.String())
+	}
+
+	options = append(options, WithContext(ctx))
+
+	for retry := 0; ; retry++ {
+		cs, resp, err := r.client.Commits.SetCommitStatus(r.pid, r.sha, opt, options...)
+		if err == nil || retry >= r.retries || !isRetryableStatusError(resp) {
+			return cs, resp, err
+		}
+
+		if err := sleepContext(ctx, r.retryInterval); err != nil {
+			return nil, resp, err
+		}
+	}
+}
+
+// isRetryableStatusError reports whether a failed status update can be
+// retried. Client errors are not retried, as they will fail again.
+func isRetryableStatusError(resp *Response) bool {
+	if resp == nil {
+		return true
+	}
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+}
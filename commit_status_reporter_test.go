@@ -0,0 +1,65 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCommitStatusReporterRun(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var states, urls []string
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/statuses/b0b3a907", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		var opt struct {
+			State     string `json:"state"`
+			TargetURL string `json:"target_url"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&opt); err != nil {
+			t.Fatalf("Failed to decode body: %v", err)
+		}
+		states = append(states, opt.State)
+		urls = append(urls, opt.TargetURL)
+
+		fmt.Fprintf(w, `{"status": %q}`, opt.State)
+	})
+
+	reporter, err := client.Commits.NewCommitStatusReporter(1, "b0b3a907", &CommitStatusReporterOptions{
+		Name:          String("external"),
+		TargetURL:     "https://ci.example.com/{{.Name}}/{{.SHA}}",
+		RetryInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Commits.NewCommitStatusReporter returned error: %v", err)
+	}
+
+	buildErr := errors.New("build failed")
+	err = reporter.Run(context.Background(), func(ctx context.Context) error {
+		return buildErr
+	})
+	if err != buildErr {
+		t.Errorf("CommitStatusReporter.Run returned error %v, want %v", err, buildErr)
+	}
+
+	if want := []string{"running", "failed"}; !reflect.DeepEqual(want, states) {
+		t.Errorf("Reported states %v, want %v", states, want)
+	}
+	if urls[0] != "https://ci.example.com/external/b0b3a907" {
+		t.Errorf("Reported target URL %s, want %s", urls[0], "https://ci.example.com/external/b0b3a907")
+	}
+}
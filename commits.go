@@ -18,7 +18,7 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
+	"strings"
 	"time"
 )
 
@@ -48,6 +48,7 @@ type Commit struct {
 	ParentIDs      []string         `json:"parent_ids"`
 	Stats          *CommitStats     `json:"stats"`
 	Status         *BuildStateValue `json:"status"`
+	LastPipeline   *PipelineInfo    `json:"last_pipeline"`
 }
 
 // CommitStats represents the number of added and deleted files in a commit.
@@ -84,7 +85,7 @@ func (s *CommitsService) ListCommits(pid interface{}, opt *ListCommitsOptions, o
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/commits", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -149,7 +150,7 @@ func (s *CommitsService) GetCommitRefs(pid interface{}, sha string, opt *GetComm
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits/%s/refs", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/commits/%s/refs", pathEscape(project), pathEscape(sha))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -174,7 +175,7 @@ func (s *CommitsService) GetCommit(pid interface{}, sha string, options ...Optio
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits/%s", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/commits/%s", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -194,8 +195,8 @@ func (s *CommitsService) GetCommit(pid interface{}, sha string, options ...Optio
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
 type CreateCommitOptions struct {
-	Branch        *string         `url:"branch" json:"branch"`
-	CommitMessage *string         `url:"commit_message" json:"commit_message"`
+	Branch        *string         `url:"branch,omitempty" json:"branch,omitempty"`
+	CommitMessage *string         `url:"commit_message,omitempty" json:"commit_message,omitempty"`
 	StartBranch   *string         `url:"start_branch,omitempty" json:"start_branch,omitempty"`
 	Actions       []*CommitAction `url:"actions" json:"actions"`
 	AuthorEmail   *string         `url:"author_email,omitempty" json:"author_email,omitempty"`
@@ -210,7 +211,7 @@ func (s *CommitsService) CreateCommit(pid interface{}, opt *CreateCommitOptions,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/commits", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -244,11 +245,41 @@ func (d Diff) String() string {
 	return Stringify(d)
 }
 
+// DiffStats represents the number of added and deleted lines in a diff.
+type DiffStats struct {
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+}
+
+// Stats counts the added and deleted lines of the diff. Both the default
+// GitLab format and the unified format (unidiff) are supported; file
+// headers preceding the first hunk are not counted.
+func (d Diff) Stats() DiffStats {
+	var stats DiffStats
+	inHunk := false
+	for _, line := range strings.Split(d.Diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case !inHunk:
+			continue
+		case strings.HasPrefix(line, "+"):
+			stats.Additions++
+		case strings.HasPrefix(line, "-"):
+			stats.Deletions++
+		}
+	}
+	return stats
+}
+
 // GetCommitDiffOptions represents the available GetCommitDiff() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/commits.html#get-the-diff-of-a-commit
-type GetCommitDiffOptions ListOptions
+type GetCommitDiffOptions struct {
+	ListOptions
+	Unidiff *bool `url:"unidiff,omitempty" json:"unidiff,omitempty"`
+}
 
 // GetCommitDiff gets the diff of a commit in a project..
 //
@@ -259,7 +290,7 @@ func (s *CommitsService) GetCommitDiff(pid interface{}, sha string, opt *GetComm
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits/%s/diff", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/commits/%s/diff", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -316,7 +347,7 @@ func (s *CommitsService) GetCommitComments(pid interface{}, sha string, opt *Get
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits/%s/comments", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/commits/%s/comments", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -339,9 +370,9 @@ func (s *CommitsService) GetCommitComments(pid interface{}, sha string, opt *Get
 // https://docs.gitlab.com/ce/api/commits.html#post-comment-to-commit
 type PostCommitCommentOptions struct {
 	Note     *string `url:"note,omitempty" json:"note,omitempty"`
-	Path     *string `url:"path" json:"path"`
-	Line     *int    `url:"line" json:"line"`
-	LineType *string `url:"line_type" json:"line_type"`
+	Path     *string `url:"path,omitempty" json:"path,omitempty"`
+	Line     *int    `url:"line,omitempty" json:"line,omitempty"`
+	LineType *string `url:"line_type,omitempty" json:"line_type,omitempty"`
 }
 
 // PostCommitComment adds a comment to a commit. Optionally you can post
@@ -355,7 +386,7 @@ func (s *CommitsService) PostCommitComment(pid interface{}, sha string, opt *Pos
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits/%s/comments", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/commits/%s/comments", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -407,7 +438,7 @@ func (s *CommitsService) GetCommitStatuses(pid interface{}, sha string, opt *Get
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/commits/%s/statuses", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/commits/%s/statuses", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -443,7 +474,7 @@ func (s *CommitsService) SetCommitStatus(pid interface{}, sha string, opt *SetCo
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/statuses/%s", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/statuses/%s", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -469,7 +500,7 @@ func (s *CommitsService) GetMergeRequestsByCommit(pid interface{}, sha string, o
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/merge_requests",
-		url.QueryEscape(project), url.QueryEscape(sha))
+		pathEscape(project), pathEscape(sha))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -501,7 +532,7 @@ func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *Cher
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/cherry_pick",
-		url.QueryEscape(project), url.QueryEscape(sha))
+		pathEscape(project), pathEscape(sha))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -516,3 +547,65 @@ func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *Cher
 
 	return c, resp, err
 }
+
+// GPGSignature represents a signed commit.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#get-gpg-signature-of-a-commit
+type GPGSignature struct {
+	SignatureType      string           `json:"signature_type"`
+	VerificationStatus string           `json:"verification_status"`
+	CommitSource       string           `json:"commit_source"`
+	GPGKeyID           int              `json:"gpg_key_id"`
+	GPGKeyPrimaryKeyID string           `json:"gpg_key_primary_keyid"`
+	GPGKeyUserName     string           `json:"gpg_key_user_name"`
+	GPGKeyUserEmail    string           `json:"gpg_key_user_email"`
+	GPGKeySubkeyID     int              `json:"gpg_key_subkey_id"`
+	X509Certificate    *X509Certificate `json:"x509_certificate"`
+	Key                *SSHKey          `json:"key"`
+	KeyFingerprint     string           `json:"key_fingerprint_sha256"`
+}
+
+// X509Certificate represents the X.509 certificate of a signed commit.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#get-gpg-signature-of-a-commit
+type X509Certificate struct {
+	ID                   int    `json:"id"`
+	Subject              string `json:"subject"`
+	SubjectKeyIdentifier string `json:"subject_key_identifier"`
+	Email                string `json:"email"`
+	SerialNumber         string `json:"serial_number"`
+	CertificateStatus    string `json:"certificate_status"`
+	X509Issuer           struct {
+		ID                   int    `json:"id"`
+		Subject              string `json:"subject"`
+		SubjectKeyIdentifier string `json:"subject_key_identifier"`
+		CrlURL               string `json:"crl_url"`
+	} `json:"x509_issuer"`
+}
+
+// GetGPGSignature gets the signature of a commit, if it is signed. Depending
+// on the signature type (PGP, X509 or SSH), the GPG key, X.509 certificate
+// or SSH key fields are set.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#get-gpg-signature-of-a-commit
+func (s *CommitsService) GetGPGSignature(pid interface{}, sha string, options ...OptionFunc) (*GPGSignature, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/commits/%s/signature",
+		pathEscape(project), pathEscape(sha))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig := new(GPGSignature)
+	resp, err := s.client.Do(req, sig)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sig, resp, err
+}
@@ -50,3 +50,151 @@ func TestSetCommitStatus(t *testing.T) {
 		t.Errorf("Commits.SetCommitStatus returned %+v, want %+v", status, want)
 	}
 }
+
+func TestGetGPGSignature(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907/signature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"signature_type": "PGP",
+			"verification_status": "verified",
+			"gpg_key_id": 1,
+			"gpg_key_primary_keyid": "8254AAB3FBD54AC9",
+			"gpg_key_user_name": "John Doe",
+			"gpg_key_user_email": "johndoe@example.com",
+			"gpg_key_subkey_id": null,
+			"commit_source": "gitaly"
+		}`)
+	})
+
+	sig, _, err := client.Commits.GetGPGSignature(1, "b0b3a907")
+	if err != nil {
+		t.Fatalf("Commits.GetGPGSignature returned error: %v", err)
+	}
+
+	want := &GPGSignature{
+		SignatureType:      "PGP",
+		VerificationStatus: "verified",
+		CommitSource:       "gitaly",
+		GPGKeyID:           1,
+		GPGKeyPrimaryKeyID: "8254AAB3FBD54AC9",
+		GPGKeyUserName:     "John Doe",
+		GPGKeyUserEmail:    "johndoe@example.com",
+	}
+	if !reflect.DeepEqual(want, sig) {
+		t.Errorf("Commits.GetGPGSignature returned %+v, want %+v", sig, want)
+	}
+}
+
+func TestGetGPGSignatureSSH(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907/signature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"signature_type": "SSH",
+			"verification_status": "verified",
+			"key": {"id": 11, "title": "Key", "key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5"},
+			"key_fingerprint_sha256": "SHA256:Ddq5G5u0bbNZxV1tGq4dKJ6vm+0HuIjWhXfZeGfJbpM",
+			"commit_source": "gitaly"
+		}`)
+	})
+
+	sig, _, err := client.Commits.GetGPGSignature(1, "b0b3a907")
+	if err != nil {
+		t.Fatalf("Commits.GetGPGSignature returned error: %v", err)
+	}
+
+	if sig.Key == nil || sig.Key.ID != 11 || sig.KeyFingerprint != "SHA256:Ddq5G5u0bbNZxV1tGq4dKJ6vm+0HuIjWhXfZeGfJbpM" {
+		t.Errorf("Commits.GetGPGSignature returned %+v", sig)
+	}
+}
+
+func TestGetMergeRequestsByCommit(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 3, "iid": 1, "title": "Add feature", "state": "merged"}]`)
+	})
+
+	mrs, _, err := client.Commits.GetMergeRequestsByCommit(1, "b0b3a907")
+	if err != nil {
+		t.Fatalf("Commits.GetMergeRequestsByCommit returned error: %v", err)
+	}
+
+	want := []*MergeRequest{{ID: 3, IID: 1, Title: "Add feature", State: "merged"}}
+	if !reflect.DeepEqual(want, mrs) {
+		t.Errorf("Commits.GetMergeRequestsByCommit returned %+v, want %+v", mrs, want)
+	}
+}
+
+func TestGetCommitRefs(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907/refs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/commits/b0b3a907/refs?type=branch")
+		fmt.Fprint(w, `[{"type": "branch", "name": "main"}, {"type": "branch", "name": "release/1.0"}]`)
+	})
+
+	refs, _, err := client.Commits.GetCommitRefs(1, "b0b3a907", &GetCommitRefsOptions{Type: String("branch")})
+	if err != nil {
+		t.Fatalf("Commits.GetCommitRefs returned error: %v", err)
+	}
+
+	want := []CommitRef{{Type: "branch", Name: "main"}, {Type: "branch", Name: "release/1.0"}}
+	if !reflect.DeepEqual(want, refs) {
+		t.Errorf("Commits.GetCommitRefs returned %+v, want %+v", refs, want)
+	}
+}
+
+func TestGetCommitLastPipeline(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"b0b3a907","last_pipeline":{"id":8,"ref":"main","sha":"b0b3a907","status":"success"}}`)
+	})
+
+	commit, _, err := client.Commits.GetCommit(1, "b0b3a907")
+	if err != nil {
+		t.Fatalf("Commits.GetCommit returned error: %v", err)
+	}
+
+	want := &PipelineInfo{ID: 8, Ref: "main", SHA: "b0b3a907", Status: "success"}
+	if !reflect.DeepEqual(want, commit.LastPipeline) {
+		t.Errorf("Commits.GetCommit returned last pipeline %+v, want %+v", commit.LastPipeline, want)
+	}
+}
+
+func TestGetCommitDiffUnidiff(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907/diff", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/commits/b0b3a907/diff?page=2&unidiff=true")
+		fmt.Fprint(w, `[{"diff":"--- a/README.md\n+++ b/README.md\n@@ -1,2 +1,3 @@\n # Title\n-old\n+new\n+---\n","new_path":"README.md","old_path":"README.md"}]`)
+	})
+
+	opt := &GetCommitDiffOptions{ListOptions: ListOptions{Page: 2}, Unidiff: Bool(true)}
+	diffs, _, err := client.Commits.GetCommitDiff(1, "b0b3a907", opt)
+	if err != nil {
+		t.Fatalf("Commits.GetCommitDiff returned error: %v", err)
+	}
+	if len(diffs) != 1 {
+		t.Fatalf("Commits.GetCommitDiff returned %d diffs, want 1", len(diffs))
+	}
+
+	want := DiffStats{Additions: 2, Deletions: 1}
+	if got := diffs[0].Stats(); got != want {
+		t.Errorf("Diff.Stats returned %+v, want %+v", got, want)
+	}
+}
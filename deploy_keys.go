@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -78,7 +77,7 @@ func (s *DeployKeysService) ListProjectDeployKeys(pid interface{}, opt *ListProj
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/deploy_keys", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/deploy_keys", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -103,7 +102,7 @@ func (s *DeployKeysService) GetDeployKey(pid interface{}, deployKey int, options
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/deploy_keys/%d", url.QueryEscape(project), deployKey)
+	u := fmt.Sprintf("projects/%s/deploy_keys/%d", pathEscape(project), deployKey)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -140,7 +139,7 @@ func (s *DeployKeysService) AddDeployKey(pid interface{}, opt *AddDeployKeyOptio
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/deploy_keys", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/deploy_keys", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -165,7 +164,7 @@ func (s *DeployKeysService) DeleteDeployKey(pid interface{}, deployKey int, opti
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/deploy_keys/%d", url.QueryEscape(project), deployKey)
+	u := fmt.Sprintf("projects/%s/deploy_keys/%d", pathEscape(project), deployKey)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -184,7 +183,7 @@ func (s *DeployKeysService) EnableDeployKey(pid interface{}, deployKey int, opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/deploy_keys/%d/enable", url.QueryEscape(project), deployKey)
+	u := fmt.Sprintf("projects/%s/deploy_keys/%d/enable", pathEscape(project), deployKey)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -16,8 +16,9 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -35,7 +36,9 @@ type Deployment struct {
 	IID         int          `json:"iid"`
 	Ref         string       `json:"ref"`
 	SHA         string       `json:"sha"`
+	Status      string       `json:"status"`
 	CreatedAt   *time.Time   `json:"created_at"`
+	UpdatedAt   *time.Time   `json:"updated_at"`
 	User        *ProjectUser `json:"user"`
 	Environment *Environment `json:"environment"`
 	Deployable  struct {
@@ -60,6 +63,33 @@ type Deployment struct {
 		} `json:"pipeline"`
 		Runner *Runner `json:"runner"`
 	} `json:"deployable"`
+	PendingApprovalCount int                   `json:"pending_approval_count"`
+	Approvals            []*DeploymentApproval `json:"approvals"`
+}
+
+// DeploymentApprovalStatusValue represents the status of a deployment approval.
+type DeploymentApprovalStatusValue string
+
+// These constants represent all valid deployment approval statuses.
+const (
+	DeploymentApproved DeploymentApprovalStatusValue = "approved"
+	DeploymentRejected DeploymentApprovalStatusValue = "rejected"
+)
+
+// DeploymentApproval represents the approval or rejection of a deployment to
+// a protected environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+type DeploymentApproval struct {
+	User      *BasicUser                    `json:"user"`
+	Status    DeploymentApprovalStatusValue `json:"status"`
+	CreatedAt *time.Time                    `json:"created_at"`
+	Comment   string                        `json:"comment"`
+}
+
+func (a DeploymentApproval) String() string {
+	return Stringify(a)
 }
 
 // ListProjectDeploymentsOptions represents the available ListProjectDeployments() options.
@@ -68,11 +98,16 @@ type Deployment struct {
 // https://docs.gitlab.com/ce/api/deployments.html#list-project-deployments
 type ListProjectDeploymentsOptions struct {
 	ListOptions
-	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
-	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+	OrderBy       *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort          *string    `url:"sort,omitempty" json:"sort,omitempty"`
+	UpdatedAfter  *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	Environment   *string    `url:"environment,omitempty" json:"environment,omitempty"`
+	Status        *string    `url:"status,omitempty" json:"status,omitempty"`
 }
 
-// ListProjectDeployments gets a list of deployments in a project.
+// ListProjectDeployments gets a list of deployments in a project. Use the
+// "blocked" status to list the deployments that are waiting for approval.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/deployments.html#list-project-deployments
 func (s *DeploymentsService) ListProjectDeployments(pid interface{}, opts *ListProjectDeploymentsOptions, options ...OptionFunc) ([]*Deployment, *Response, error) {
@@ -80,7 +115,7 @@ func (s *DeploymentsService) ListProjectDeployments(pid interface{}, opts *ListP
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/deployments", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/deployments", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opts, options)
 	if err != nil {
@@ -104,7 +139,7 @@ func (s *DeploymentsService) GetProjectDeployment(pid interface{}, deployment in
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/deployments/%d", url.QueryEscape(project), deployment)
+	u := fmt.Sprintf("projects/%s/deployments/%d", pathEscape(project), deployment)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -119,3 +154,109 @@ func (s *DeploymentsService) GetProjectDeployment(pid interface{}, deployment in
 
 	return d, resp, err
 }
+
+// ApproveOrRejectProjectDeploymentOptions represents the available
+// ApproveOrRejectProjectDeployment() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+type ApproveOrRejectProjectDeploymentOptions struct {
+	Status        *DeploymentApprovalStatusValue `url:"status,omitempty" json:"status,omitempty"`
+	Comment       *string                        `url:"comment,omitempty" json:"comment,omitempty"`
+	RepresentedAs *string                        `url:"represented_as,omitempty" json:"represented_as,omitempty"`
+}
+
+// ApproveOrRejectProjectDeployment approves or rejects a blocked deployment
+// to a protected environment. RepresentedAs selects the user, group or role
+// the approval is given as, when the user belongs to several of the
+// environment's approval rules. Deployments to environments protected at the
+// group level are approved the same way.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/deployments.html#approve-or-reject-a-blocked-deployment
+func (s *DeploymentsService) ApproveOrRejectProjectDeployment(pid interface{}, deployment int, opt *ApproveOrRejectProjectDeploymentOptions, options ...OptionFunc) (*DeploymentApproval, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/deployments/%d/approval", pathEscape(project), deployment)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(DeploymentApproval)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// WaitForDeploymentOptions represents the available WaitForDeployment() and
+// WaitForEnvironmentDeployment() options.
+type WaitForDeploymentOptions struct {
+	// Interval is the time to wait between two polls. Defaults to 10 seconds.
+	Interval time.Duration
+
+	// Timeout is the maximum time to wait for the deployment to finish. No
+	// timeout is applied if zero.
+	Timeout time.Duration
+}
+
+// WaitForDeployment polls a deployment until it reaches a terminal state
+// (success, failed or canceled) and returns the final deployment.
+func (s *DeploymentsService) WaitForDeployment(ctx context.Context, pid interface{}, deployment int, opt *WaitForDeploymentOptions, options ...OptionFunc) (*Deployment, *Response, error) {
+	return waitForDeployment(ctx, opt, func(ctx context.Context) (*Deployment, *Response, error) {
+		return s.GetProjectDeployment(pid, deployment, append(options, WithContext(ctx))...)
+	})
+}
+
+// WaitForEnvironmentDeployment polls the last deployment of an environment
+// until it reaches a terminal state (success, failed or canceled) and returns
+// the final deployment.
+func (s *DeploymentsService) WaitForEnvironmentDeployment(ctx context.Context, pid interface{}, environment int, opt *WaitForDeploymentOptions, options ...OptionFunc) (*Deployment, *Response, error) {
+	return waitForDeployment(ctx, opt, func(ctx context.Context) (*Deployment, *Response, error) {
+		env, resp, err := s.client.Environments.GetEnvironment(pid, environment, append(options, WithContext(ctx))...)
+		if err != nil {
+			return nil, resp, err
+		}
+		if env.LastDeployment == nil {
+			return nil, resp, errors.New("environment has no deployments")
+		}
+		return env.LastDeployment, resp, nil
+	})
+}
+
+func waitForDeployment(ctx context.Context, opt *WaitForDeploymentOptions, get func(context.Context) (*Deployment, *Response, error)) (*Deployment, *Response, error) {
+	interval := 10 * time.Second
+	popt := new(PollOptions)
+	if opt != nil && opt.Interval > 0 {
+		interval = opt.Interval
+	}
+	if opt != nil {
+		popt.MaxDuration = opt.Timeout
+	}
+
+	var d *Deployment
+	var resp *Response
+
+	err := PollUntil(ctx, interval, func(ctx context.Context) (bool, error) {
+		var err error
+		d, resp, err = get(ctx)
+		if err != nil {
+			d = nil
+			return false, err
+		}
+
+		switch d.Status {
+		case "success", "failed", "canceled":
+			return true, nil
+		}
+		return false, nil
+	}, popt)
+
+	return d, resp, err
+}
@@ -0,0 +1,107 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWaitForDeployment(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/deployments/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 2 {
+			fmt.Fprint(w, `{"id": 42, "status": "running"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 42, "status": "success"}`)
+	})
+
+	opt := &WaitForDeploymentOptions{Interval: time.Millisecond}
+	d, _, err := client.Deployments.WaitForDeployment(context.Background(), 1, 42, opt)
+	if err != nil {
+		t.Fatalf("Deployments.WaitForDeployment returned error: %v", err)
+	}
+	if d.Status != "success" {
+		t.Errorf("Deployment status is %s, want %s", d.Status, "success")
+	}
+}
+
+func TestWaitForDeploymentTimeout(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "last_deployment": {"id": 42, "status": "running"}}`)
+	})
+
+	opt := &WaitForDeploymentOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}
+	_, _, err := client.Deployments.WaitForEnvironmentDeployment(context.Background(), 1, 1, opt)
+	if err == nil {
+		t.Error("Expected Deployments.WaitForEnvironmentDeployment to time out")
+	}
+}
+
+func TestListBlockedProjectDeployments(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/deployments?environment=production&status=blocked")
+		fmt.Fprint(w, `[{
+			"id": 42,
+			"status": "blocked",
+			"pending_approval_count": 1,
+			"approvals": [{"user": {"id": 2, "username": "alice"}, "status": "approved", "comment": "LGTM"}]
+		}]`)
+	})
+
+	opt := &ListProjectDeploymentsOptions{Environment: String("production"), Status: String("blocked")}
+	ds, _, err := client.Deployments.ListProjectDeployments(1, opt)
+	if err != nil {
+		t.Fatalf("Deployments.ListProjectDeployments returned error: %v", err)
+	}
+
+	if len(ds) != 1 || ds[0].PendingApprovalCount != 1 {
+		t.Fatalf("Deployments.ListProjectDeployments returned %+v, want one deployment pending approval", ds)
+	}
+	want := []*DeploymentApproval{{User: &BasicUser{ID: 2, Username: "alice"}, Status: DeploymentApproved, Comment: "LGTM"}}
+	if !reflect.DeepEqual(want, ds[0].Approvals) {
+		t.Errorf("Deployments.ListProjectDeployments returned approvals %+v, want %+v", ds[0].Approvals, want)
+	}
+}
+
+func TestApproveOrRejectProjectDeployment(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments/42/approval", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"status":"rejected","comment":"Not today","represented_as":"security"}`)
+		fmt.Fprint(w, `{"user": {"id": 2, "username": "alice"}, "status": "rejected", "comment": "Not today"}`)
+	})
+
+	opt := &ApproveOrRejectProjectDeploymentOptions{
+		Status:        DeploymentApprovalStatus(DeploymentRejected),
+		Comment:       String("Not today"),
+		RepresentedAs: String("security"),
+	}
+	a, _, err := client.Deployments.ApproveOrRejectProjectDeployment(1, 42, opt)
+	if err != nil {
+		t.Fatalf("Deployments.ApproveOrRejectProjectDeployment returned error: %v", err)
+	}
+
+	want := &DeploymentApproval{User: &BasicUser{ID: 2, Username: "alice"}, Status: DeploymentRejected, Comment: "Not today"}
+	if !reflect.DeepEqual(want, a) {
+		t.Errorf("Deployments.ApproveOrRejectProjectDeployment returned %+v, want %+v", a, want)
+	}
+}
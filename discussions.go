@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -60,7 +59,7 @@ func (s *DiscussionsService) ListIssueDiscussions(pid interface{}, issue int, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/discussions", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d/discussions", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -86,7 +85,7 @@ func (s *DiscussionsService) GetIssueDiscussion(pid interface{}, issue int, disc
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/issues/%d/discussions/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		issue,
 		discussion,
 	)
@@ -124,7 +123,7 @@ func (s *DiscussionsService) CreateIssueDiscussion(pid interface{}, issue int, o
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/discussions", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d/discussions", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -160,7 +159,7 @@ func (s *DiscussionsService) AddIssueDiscussionNote(pid interface{}, issue int,
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/issues/%d/discussions/%s/notes",
-		url.QueryEscape(project),
+		pathEscape(project),
 		issue,
 		discussion,
 	)
@@ -199,7 +198,7 @@ func (s *DiscussionsService) UpdateIssueDiscussionNote(pid interface{}, issue in
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/issues/%d/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		issue,
 		discussion,
 		note,
@@ -229,7 +228,7 @@ func (s *DiscussionsService) DeleteIssueDiscussionNote(pid interface{}, issue in
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/issues/%d/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		issue,
 		discussion,
 		note,
@@ -260,7 +259,7 @@ func (s *DiscussionsService) ListSnippetDiscussions(pid interface{}, snippet int
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/snippets/%d/discussions", url.QueryEscape(project), snippet)
+	u := fmt.Sprintf("projects/%s/snippets/%d/discussions", pathEscape(project), snippet)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -286,7 +285,7 @@ func (s *DiscussionsService) GetSnippetDiscussion(pid interface{}, snippet int,
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/snippets/%d/discussions/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		snippet,
 		discussion,
 	)
@@ -325,7 +324,7 @@ func (s *DiscussionsService) CreateSnippetDiscussion(pid interface{}, snippet in
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/snippets/%d/discussions", url.QueryEscape(project), snippet)
+	u := fmt.Sprintf("projects/%s/snippets/%d/discussions", pathEscape(project), snippet)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -362,7 +361,7 @@ func (s *DiscussionsService) AddSnippetDiscussionNote(pid interface{}, snippet i
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/snippets/%d/discussions/%s/notes",
-		url.QueryEscape(project),
+		pathEscape(project),
 		snippet,
 		discussion,
 	)
@@ -401,7 +400,7 @@ func (s *DiscussionsService) UpdateSnippetDiscussionNote(pid interface{}, snippe
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/snippets/%d/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		snippet,
 		discussion,
 		note,
@@ -431,7 +430,7 @@ func (s *DiscussionsService) DeleteSnippetDiscussionNote(pid interface{}, snippe
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/snippets/%d/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		snippet,
 		discussion,
 		note,
@@ -463,7 +462,7 @@ func (s *DiscussionsService) ListGroupEpicDiscussions(gid interface{}, epic int,
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/epics/%d/discussions",
-		url.QueryEscape(group),
+		pathEscape(group),
 		epic,
 	)
 
@@ -491,7 +490,7 @@ func (s *DiscussionsService) GetEpicDiscussion(gid interface{}, epic int, discus
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/epics/%d/discussions/%s",
-		url.QueryEscape(group),
+		pathEscape(group),
 		epic,
 		discussion,
 	)
@@ -531,7 +530,7 @@ func (s *DiscussionsService) CreateEpicDiscussion(gid interface{}, epic int, opt
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/epics/%d/discussions",
-		url.QueryEscape(group),
+		pathEscape(group),
 		epic,
 	)
 
@@ -569,7 +568,7 @@ func (s *DiscussionsService) AddEpicDiscussionNote(gid interface{}, epic int, di
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/epics/%d/discussions/%s/notes",
-		url.QueryEscape(group),
+		pathEscape(group),
 		epic,
 		discussion,
 	)
@@ -608,7 +607,7 @@ func (s *DiscussionsService) UpdateEpicDiscussionNote(gid interface{}, epic int,
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/epics/%d/discussions/%s/notes/%d",
-		url.QueryEscape(group),
+		pathEscape(group),
 		epic,
 		discussion,
 		note,
@@ -638,7 +637,7 @@ func (s *DiscussionsService) DeleteEpicDiscussionNote(gid interface{}, epic int,
 		return nil, err
 	}
 	u := fmt.Sprintf("groups/%s/epics/%d/discussions/%s/notes/%d",
-		url.QueryEscape(group),
+		pathEscape(group),
 		epic,
 		discussion,
 		note,
@@ -670,7 +669,7 @@ func (s *DiscussionsService) ListMergeRequestDiscussions(pid interface{}, mergeR
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions",
-		url.QueryEscape(project),
+		pathEscape(project),
 		mergeRequest,
 	)
 
@@ -699,7 +698,7 @@ func (s *DiscussionsService) GetMergeRequestDiscussion(pid interface{}, mergeReq
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		mergeRequest,
 		discussion,
 	)
@@ -740,7 +739,7 @@ func (s *DiscussionsService) CreateMergeRequestDiscussion(pid interface{}, merge
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions",
-		url.QueryEscape(project),
+		pathEscape(project),
 		mergeRequest,
 	)
 
@@ -778,7 +777,7 @@ func (s *DiscussionsService) ResolveMergeRequestDiscussion(pid interface{}, merg
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		mergeRequest,
 		discussion,
 	)
@@ -818,7 +817,7 @@ func (s *DiscussionsService) AddMergeRequestDiscussionNote(pid interface{}, merg
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s/notes",
-		url.QueryEscape(project),
+		pathEscape(project),
 		mergeRequest,
 		discussion,
 	)
@@ -859,7 +858,7 @@ func (s *DiscussionsService) UpdateMergeRequestDiscussionNote(pid interface{}, m
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		mergeRequest,
 		discussion,
 		note,
@@ -890,7 +889,7 @@ func (s *DiscussionsService) DeleteMergeRequestDiscussionNote(pid interface{}, m
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		mergeRequest,
 		discussion,
 		note,
@@ -922,7 +921,7 @@ func (s *DiscussionsService) ListCommitDiscussions(pid interface{}, commit strin
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/discussions",
-		url.QueryEscape(project),
+		pathEscape(project),
 		commit,
 	)
 
@@ -951,7 +950,7 @@ func (s *DiscussionsService) GetCommitDiscussion(pid interface{}, commit string,
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/discussions/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		commit,
 		discussion,
 	)
@@ -991,7 +990,7 @@ func (s *DiscussionsService) CreateCommitDiscussion(pid interface{}, commit stri
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/discussions",
-		url.QueryEscape(project),
+		pathEscape(project),
 		commit,
 	)
 
@@ -1029,7 +1028,7 @@ func (s *DiscussionsService) AddCommitDiscussionNote(pid interface{}, commit str
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/discussions/%s/notes",
-		url.QueryEscape(project),
+		pathEscape(project),
 		commit,
 		discussion,
 	)
@@ -1068,7 +1067,7 @@ func (s *DiscussionsService) UpdateCommitDiscussionNote(pid interface{}, commit
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		commit,
 		discussion,
 		note,
@@ -1098,7 +1097,7 @@ func (s *DiscussionsService) DeleteCommitDiscussionNote(pid interface{}, commit
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/repository/commits/%s/discussions/%s/notes/%d",
-		url.QueryEscape(project),
+		pathEscape(project),
 		commit,
 		discussion,
 		note,
@@ -0,0 +1,69 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// DockerfileTemplatesService handles communication with the Dockerfile
+// templates related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/templates/dockerfiles.html
+type DockerfileTemplatesService struct {
+	client *Client
+}
+
+// DockerfileTemplate represents a GitLab Dockerfile template.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/templates/dockerfiles.html
+type DockerfileTemplate struct {
+	Key     string `json:"key"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// ListDockerfileTemplatesOptions represents the available
+// ListTemplates() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/templates/dockerfiles.html#list-dockerfile-templates
+type ListDockerfileTemplatesOptions ListOptions
+
+// ListTemplates get a list of available Dockerfile templates.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/templates/dockerfiles.html#list-dockerfile-templates
+func (s *DockerfileTemplatesService) ListTemplates(opt *ListDockerfileTemplatesOptions, options ...OptionFunc) ([]*DockerfileTemplate, *Response, error) {
+	req, err := s.client.NewRequest("GET", "templates/dockerfiles", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ds []*DockerfileTemplate
+	resp, err := s.client.Do(req, &ds)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ds, resp, err
+}
+
+// GetTemplate get a single Dockerfile template.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/templates/dockerfiles.html#single-dockerfile-template
+func (s *DockerfileTemplatesService) GetTemplate(key string, options ...OptionFunc) (*DockerfileTemplate, *Response, error) {
+	u := fmt.Sprintf("templates/dockerfiles/%s", pathEscape(key))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(DockerfileTemplate)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, err
+}
@@ -0,0 +1,117 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+// dryRunKey is the context key used to store the writer of a per request
+// dry-run.
+type dryRunKey struct{}
+
+// readOnlyKey is the context key used to mark POST requests that don't change
+// anything, like GraphQL queries, so they are still sent in dry-run mode.
+type readOnlyKey struct{}
+
+// markReadOnly marks req as a read-only request.
+func markReadOnly(req *http.Request) {
+	*req = *req.WithContext(context.WithValue(req.Context(), readOnlyKey{}, true))
+}
+
+// WithDryRun makes the client intercept all mutating requests (POST, PUT,
+// PATCH and DELETE, except for POST requests used to read data like GraphQL
+// queries and Git LFS batch requests). Instead of sending them, the fully built request is
+// written to w, without any credentials, and a synthetic 204 No Content
+// response is returned. Read-only requests are still sent. If w is nil, the
+// requests are written to os.Stderr.
+//
+// This is useful for previewing the changes a reconciliation run would make.
+// Note that the values returned by the API methods are left empty in dry-run
+// mode.
+func WithDryRun(w io.Writer) ClientOptionFunc {
+	return func(c *Client) {
+		if w == nil {
+			w = os.Stderr
+		}
+		c.dryRun = w
+	}
+}
+
+// WithRequestDryRun enables dry-run mode for a single request. See WithDryRun
+// for details. When used together with WithContext, WithContext must be passed
+// first as it replaces the request context.
+func WithRequestDryRun(w io.Writer) OptionFunc {
+	return func(req *http.Request) error {
+		if w == nil {
+			w = os.Stderr
+		}
+		*req = *req.WithContext(context.WithValue(req.Context(), dryRunKey{}, w))
+		return nil
+	}
+}
+
+// dryRunWriter returns the writer to log the request to if the request should
+// not be send because of dry-run mode.
+func (c *Client) dryRunWriter(req *http.Request) (io.Writer, bool) {
+	switch req.Method {
+	case "POST", "PUT", "PATCH", "DELETE":
+	default:
+		return nil, false
+	}
+	if ro, _ := req.Context().Value(readOnlyKey{}).(bool); ro {
+		return nil, false
+	}
+
+	if w, ok := req.Context().Value(dryRunKey{}).(io.Writer); ok {
+		return w, true
+	}
+	if c.dryRun != nil {
+		return c.dryRun, true
+	}
+	return nil, false
+}
+
+// dryRun writes the request to w and returns a synthetic response.
+func dryRun(w io.Writer, req *http.Request) (*Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	header := scrubHeaders(req.Header)
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[dry-run] %s %s\n", req.Method, req.URL)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s: %s\n", k, strings.Join(header[k], ", "))
+	}
+	if len(body) > 0 {
+		fmt.Fprintf(&b, "\n%s\n", body)
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return nil, err
+	}
+
+	return newResponse(&http.Response{
+		Status:     "204 No Content",
+		StatusCode: http.StatusNoContent,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}), nil
+}
@@ -0,0 +1,99 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var buf bytes.Buffer
+	WithDryRun(&buf)(client)
+
+	mux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Request method %s was sent in dry-run mode", r.Method)
+		}
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+
+	labels, _, err := client.Labels.ListLabels(1, nil)
+	if err != nil || len(labels) != 1 {
+		t.Fatalf("Labels.ListLabels returned %+v, %v", labels, err)
+	}
+
+	label, resp, err := client.Labels.CreateLabel(1, &CreateLabelOptions{Name: String("bug")})
+	if err != nil {
+		t.Fatalf("Labels.CreateLabel returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent || label.ID != 0 {
+		t.Errorf("Labels.CreateLabel returned %+v with status %d", label, resp.StatusCode)
+	}
+
+	want := fmt.Sprintf("[dry-run] POST %s/api/v4/projects/1/labels\n"+
+		"Accept: application/json\nContent-Type: application/json\nUser-Agent: go-gitlab\n\n"+
+		"{\"name\":\"bug\"}\n", server.URL)
+	if buf.String() != want {
+		t.Errorf("Dry-run logged %q, want %q", buf.String(), want)
+	}
+}
+
+func TestRequestDryRun(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	})
+
+	var buf bytes.Buffer
+	_, err := client.Labels.DeleteLabel(1, &DeleteLabelOptions{Name: String("bug")}, WithRequestDryRun(&buf))
+	if err != nil {
+		t.Fatalf("Labels.DeleteLabel returned error: %v", err)
+	}
+
+	if calls != 0 {
+		t.Errorf("Expected no requests to be sent, got %d", calls)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("[dry-run] DELETE ")) {
+		t.Errorf("Dry-run logged %q", buf.String())
+	}
+}
+
+func TestDryRunGraphQLQuery(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var buf bytes.Buffer
+	WithDryRun(&buf)(client)
+
+	var calls int
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"data": {"currentUser": {"username": "root"}}}`)
+	})
+
+	_, err := client.GraphQL.Do(&GraphQLQuery{Query: "{ currentUser { username } }"}, nil)
+	if err != nil {
+		t.Fatalf("GraphQL.Do returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the query to be sent, got %d requests", calls)
+	}
+
+	_, err = client.GraphQL.Do(&GraphQLQuery{Query: "mutation { todoMarkDone(input: {id: \"1\"}) { errors } }"}, nil)
+	if err != nil {
+		t.Fatalf("GraphQL.Do returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the mutation not to be sent, got %d requests", calls)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("[dry-run] POST ")) {
+		t.Errorf("Dry-run logged %q", buf.String())
+	}
+}
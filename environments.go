@@ -18,7 +18,7 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
+	"time"
 )
 
 // EnvironmentsService handles communication with the environment related methods
@@ -33,10 +33,14 @@ type EnvironmentsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/environments.html
 type Environment struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Slug        string `json:"slug"`
-	ExternalURL string `json:"external_url"`
+	ID              int         `json:"id"`
+	Name            string      `json:"name"`
+	Slug            string      `json:"slug"`
+	State           string      `json:"state,omitempty"`
+	ExternalURL     string      `json:"external_url"`
+	LastDeployment  *Deployment `json:"last_deployment,omitempty"`
+	AutoStopAt      *time.Time  `json:"auto_stop_at"`
+	AutoStopSetting string      `json:"auto_stop_setting"`
 }
 
 func (env Environment) String() string {
@@ -59,7 +63,7 @@ func (s *EnvironmentsService) ListEnvironments(pid interface{}, opts *ListEnviro
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/environments", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/environments", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opts, options)
 	if err != nil {
@@ -75,6 +79,32 @@ func (s *EnvironmentsService) ListEnvironments(pid interface{}, opts *ListEnviro
 	return envs, resp, err
 }
 
+// GetEnvironment gets a specific environment from a project, including its
+// last deployment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#get-a-specific-environment
+func (s *EnvironmentsService) GetEnvironment(pid interface{}, environment int, options ...OptionFunc) (*Environment, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/environments/%d", pathEscape(project), environment)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	env := new(Environment)
+	resp, err := s.client.Do(req, env)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return env, resp, err
+}
+
 // CreateEnvironmentOptions represents the available CreateEnvironment() options.
 //
 // GitLab API docs:
@@ -96,7 +126,7 @@ func (s *EnvironmentsService) CreateEnvironment(pid interface{}, opt *CreateEnvi
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/environments", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/environments", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -117,8 +147,9 @@ func (s *EnvironmentsService) CreateEnvironment(pid interface{}, opt *CreateEnvi
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#edit-an-existing-environment
 type EditEnvironmentOptions struct {
-	Name        *string `url:"name,omitempty" json:"name,omitempty"`
-	ExternalURL *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	Name            *string `url:"name,omitempty" json:"name,omitempty"`
+	ExternalURL     *string `url:"external_url,omitempty" json:"external_url,omitempty"`
+	AutoStopSetting *string `url:"auto_stop_setting,omitempty" json:"auto_stop_setting,omitempty"`
 }
 
 // EditEnvironment updates a project team environment to a specified access level..
@@ -130,7 +161,7 @@ func (s *EnvironmentsService) EditEnvironment(pid interface{}, environment int,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/environments/%d", url.QueryEscape(project), environment)
+	u := fmt.Sprintf("projects/%s/environments/%d", pathEscape(project), environment)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -155,7 +186,7 @@ func (s *EnvironmentsService) DeleteEnvironment(pid interface{}, environment int
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/environments/%d", url.QueryEscape(project), environment)
+	u := fmt.Sprintf("projects/%s/environments/%d", pathEscape(project), environment)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -174,7 +205,7 @@ func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/environments/%d/stop", url.QueryEscape(project), environmentID)
+	u := fmt.Sprintf("projects/%s/environments/%d/stop", pathEscape(project), environmentID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -183,3 +214,33 @@ func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int
 
 	return s.client.Do(req, nil)
 }
+
+// StopStaleEnvironmentsOptions represents the available
+// StopStaleEnvironments() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-stale-environments
+type StopStaleEnvironmentsOptions struct {
+	Before *time.Time `url:"before,omitempty" json:"before,omitempty"`
+}
+
+// StopStaleEnvironments stops all environments that were last modified or
+// deployed to before the given date. Protected environments are ignored.
+// The Before date must be between 10 years ago and 1 week ago.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/environments.html#stop-stale-environments
+func (s *EnvironmentsService) StopStaleEnvironments(pid interface{}, opt *StopStaleEnvironmentsOptions, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/environments/stop_stale", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
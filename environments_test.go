@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListEnvironments(t *testing.T) {
@@ -98,3 +99,59 @@ func TestStopEnvironment(t *testing.T) {
 		log.Fatal(err)
 	}
 }
+
+func TestGetEnvironment(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "name": "review/fix-foo", "state": "available", "last_deployment": {"id": 100, "iid": 34, "status": "success"}}`)
+	})
+
+	env, _, err := client.Environments.GetEnvironment(1, 1)
+	if err != nil {
+		t.Errorf("Environments.GetEnvironment returned error: %v", err)
+	}
+
+	want := &Environment{ID: 1, Name: "review/fix-foo", State: "available", LastDeployment: &Deployment{ID: 100, IID: 34, Status: "success"}}
+	if !reflect.DeepEqual(want, env) {
+		t.Errorf("Environments.GetEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
+func TestGetEnvironmentAutoStop(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "name": "review/fix-foo", "auto_stop_at": "2026-10-20T10:00:00Z", "auto_stop_setting": "always"}`)
+	})
+
+	env, _, err := client.Environments.GetEnvironment(1, 1)
+	if err != nil {
+		t.Errorf("Environments.GetEnvironment returned error: %v", err)
+	}
+
+	want := time.Date(2026, time.October, 20, 10, 0, 0, 0, time.UTC)
+	if env.AutoStopAt == nil || !env.AutoStopAt.Equal(want) || env.AutoStopSetting != "always" {
+		t.Errorf("Environments.GetEnvironment returned %+v, want auto stop at %v", env, want)
+	}
+}
+
+func TestStopStaleEnvironments(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/stop_stale", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"before":"2026-09-01T00:00:00Z"}`)
+	})
+
+	before := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	_, err := client.Environments.StopStaleEnvironments(1, &StopStaleEnvironmentsOptions{Before: &before})
+	if err != nil {
+		t.Errorf("Environments.StopStaleEnvironments returned error: %v", err)
+	}
+}
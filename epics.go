@@ -0,0 +1,163 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// EpicsService handles communication with the epic related methods
+// of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/epics.html
+type EpicsService struct {
+	client *Client
+}
+
+// EpicAuthor represents a author of the epic.
+type EpicAuthor struct {
+	ID        int    `json:"id"`
+	State     string `json:"state"`
+	WebURL    string `json:"web_url"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+	Username  string `json:"username"`
+}
+
+// Epic represents a GitLab epic.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/epics.html
+type Epic struct {
+	ID                           int         `json:"id"`
+	IID                          int         `json:"iid"`
+	GroupID                      int         `json:"group_id"`
+	ParentID                     int         `json:"parent_id"`
+	Title                        string      `json:"title"`
+	Description                  string      `json:"description"`
+	State                        string      `json:"state"`
+	WebURL                       string      `json:"web_url"`
+	Author                       *EpicAuthor `json:"author"`
+	StartDate                    *ISOTime    `json:"start_date"`
+	DueDate                      *ISOTime    `json:"due_date"`
+	StartDateIsFixed             bool        `json:"start_date_is_fixed"`
+	StartDateFixed               *ISOTime    `json:"start_date_fixed"`
+	StartDateFromInheritedSource *ISOTime    `json:"start_date_from_inherited_source"`
+	DueDateIsFixed               bool        `json:"due_date_is_fixed"`
+	DueDateFixed                 *ISOTime    `json:"due_date_fixed"`
+	DueDateFromInheritedSource   *ISOTime    `json:"due_date_from_inherited_source"`
+	Labels                       []string    `json:"labels"`
+	Upvotes                      int         `json:"upvotes"`
+	Downvotes                    int         `json:"downvotes"`
+	UserNotesCount               int         `json:"user_notes_count"`
+	CreatedAt                    *time.Time  `json:"created_at"`
+	UpdatedAt                    *time.Time  `json:"updated_at"`
+	ClosedAt                     *time.Time  `json:"closed_at"`
+	RelatedEpicLinkID            int         `json:"related_epic_link_id"`
+	LinkType                     string      `json:"link_type"`
+}
+
+func (e Epic) String() string {
+	return Stringify(e)
+}
+
+// GetEpic gets a single group epic.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/epics.html#single-epic
+func (s *EpicsService) GetEpic(gid interface{}, epic int, options ...OptionFunc) (*Epic, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d", pathEscape(group), epic)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(Epic)
+	resp, err := s.client.Do(req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e, resp, err
+}
+
+// UpdateEpicOptions represents the available UpdateEpic() options. The start
+// and due dates of an epic are either fixed, in which case StartDateFixed
+// and DueDateFixed are used, or inherited from its milestones and child
+// epics.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/epics.html#update-epic
+type UpdateEpicOptions struct {
+	Title            *string  `url:"title,omitempty" json:"title,omitempty"`
+	Description      *string  `url:"description,omitempty" json:"description,omitempty"`
+	Labels           Labels   `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	StartDateIsFixed *bool    `url:"start_date_is_fixed,omitempty" json:"start_date_is_fixed,omitempty"`
+	StartDateFixed   *ISOTime `url:"start_date_fixed,omitempty" json:"start_date_fixed,omitempty"`
+	DueDateIsFixed   *bool    `url:"due_date_is_fixed,omitempty" json:"due_date_is_fixed,omitempty"`
+	DueDateFixed     *ISOTime `url:"due_date_fixed,omitempty" json:"due_date_fixed,omitempty"`
+	StateEvent       *string  `url:"state_event,omitempty" json:"state_event,omitempty"`
+	ParentID         *int     `url:"parent_id,omitempty" json:"parent_id,omitempty"`
+}
+
+// UpdateEpic updates an existing group epic.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/epics.html#update-epic
+func (s *EpicsService) UpdateEpic(gid interface{}, epic int, opt *UpdateEpicOptions, options ...OptionFunc) (*Epic, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d", pathEscape(group), epic)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(Epic)
+	resp, err := s.client.Do(req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e, resp, err
+}
+
+// ReorderChildEpicOptions represents the available ReorderChildEpic()
+// options. MoveBeforeID and MoveAfterID are the global IDs of sibling
+// child epics.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/epic_links.html#re-order-a-child-epic
+type ReorderChildEpicOptions struct {
+	MoveBeforeID *int `url:"move_before_id,omitempty" json:"move_before_id,omitempty"`
+	MoveAfterID  *int `url:"move_after_id,omitempty" json:"move_after_id,omitempty"`
+}
+
+// ReorderChildEpic moves a child epic of an epic before or after one of its
+// siblings, and returns the reordered child epics.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/epic_links.html#re-order-a-child-epic
+func (s *EpicsService) ReorderChildEpic(gid interface{}, epic, childEpicID int, opt *ReorderChildEpicOptions, options ...OptionFunc) ([]*Epic, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/epics/%d", pathEscape(group), epic, childEpicID)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var es []*Epic
+	resp, err := s.client.Do(req, &es)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return es, resp, err
+}
@@ -0,0 +1,78 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpdateEpicFixedDates(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/2/epics/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"start_date_is_fixed":true,"start_date_fixed":"2026-01-05","due_date_is_fixed":false}`)
+		fmt.Fprint(w, `{
+			"id": 10,
+			"iid": 5,
+			"start_date": "2026-01-05",
+			"start_date_is_fixed": true,
+			"start_date_fixed": "2026-01-05",
+			"start_date_from_inherited_source": "2026-02-01",
+			"due_date": "2026-03-31",
+			"due_date_is_fixed": false,
+			"due_date_from_inherited_source": "2026-03-31"
+		}`)
+	})
+
+	start := ISOTime(time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC))
+	opt := &UpdateEpicOptions{
+		StartDateIsFixed: Bool(true),
+		StartDateFixed:   &start,
+		DueDateIsFixed:   Bool(false),
+	}
+	epic, _, err := client.Epics.UpdateEpic(2, 5, opt)
+	if err != nil {
+		t.Fatalf("Epics.UpdateEpic returned error: %v", err)
+	}
+
+	inheritedStart := ISOTime(time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC))
+	due := ISOTime(time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC))
+	want := &Epic{
+		ID:                           10,
+		IID:                          5,
+		StartDate:                    &start,
+		StartDateIsFixed:             true,
+		StartDateFixed:               &start,
+		StartDateFromInheritedSource: &inheritedStart,
+		DueDate:                      &due,
+		DueDateFromInheritedSource:   &due,
+	}
+	if !reflect.DeepEqual(want, epic) {
+		t.Errorf("Epics.UpdateEpic returned %+v, want %+v", epic, want)
+	}
+}
+
+func TestReorderChildEpic(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/2/epics/5/epics/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"move_before_id":11}`)
+		fmt.Fprint(w, `[{"id": 12, "iid": 7}, {"id": 11, "iid": 6}]`)
+	})
+
+	epics, _, err := client.Epics.ReorderChildEpic(2, 5, 12, &ReorderChildEpicOptions{MoveBeforeID: Int(11)})
+	if err != nil {
+		t.Fatalf("Epics.ReorderChildEpic returned error: %v", err)
+	}
+
+	want := []*Epic{{ID: 12, IID: 7}, {ID: 11, IID: 6}}
+	if !reflect.DeepEqual(want, epics) {
+		t.Errorf("Epics.ReorderChildEpic returned %+v, want %+v", epics, want)
+	}
+}
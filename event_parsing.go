@@ -11,14 +11,21 @@ type EventType string
 
 // List of available event types.
 const (
-	EventTypeBuild        EventType = "Build Hook"
-	EventTypeIssue        EventType = "Issue Hook"
-	EventTypeMergeRequest EventType = "Merge Request Hook"
-	EventTypeNote         EventType = "Note Hook"
-	EventTypePipeline     EventType = "Pipeline Hook"
-	EventTypePush         EventType = "Push Hook"
-	EventTypeTagPush      EventType = "Tag Push Hook"
-	EventTypeWikiPage     EventType = "Wiki Page Hook"
+	EventTypeBuild             EventType = "Build Hook"
+	EventTypeConfidentialIssue EventType = "Confidential Issue Hook"
+	EventTypeConfidentialNote  EventType = "Confidential Note Hook"
+	EventTypeDeployment        EventType = "Deployment Hook"
+	EventTypeEmoji             EventType = "Emoji Hook"
+	EventTypeFeatureFlag       EventType = "Feature Flag Hook"
+	EventTypeIssue             EventType = "Issue Hook"
+	EventTypeJob               EventType = "Job Hook"
+	EventTypeMergeRequest      EventType = "Merge Request Hook"
+	EventTypeNote              EventType = "Note Hook"
+	EventTypePipeline          EventType = "Pipeline Hook"
+	EventTypePush              EventType = "Push Hook"
+	EventTypeRelease           EventType = "Release Hook"
+	EventTypeTagPush           EventType = "Tag Push Hook"
+	EventTypeWikiPage          EventType = "Wiki Page Hook"
 )
 
 const (
@@ -37,6 +44,14 @@ type noteEvent struct {
 
 const eventTypeHeader = "X-Gitlab-Event"
 
+// unknownEventTypeError is returned by ParseWebhook for event types it does
+// not recognize.
+type unknownEventTypeError EventType
+
+func (e unknownEventTypeError) Error() string {
+	return fmt.Sprintf("unexpected event type: %s", string(e))
+}
+
 // WebhookEventType returns the event type for the given request.
 func WebhookEventType(r *http.Request) EventType {
 	return EventType(r.Header.Get(eventTypeHeader))
@@ -66,19 +81,29 @@ func ParseWebhook(eventType EventType, payload []byte) (event interface{}, err e
 	switch eventType {
 	case EventTypeBuild:
 		event = &BuildEvent{}
-	case EventTypeIssue:
+	case EventTypeDeployment:
+		event = &DeploymentEvent{}
+	case EventTypeEmoji:
+		event = &EmojiEvent{}
+	case EventTypeFeatureFlag:
+		event = &FeatureFlagEvent{}
+	case EventTypeIssue, EventTypeConfidentialIssue:
 		event = &IssueEvent{}
+	case EventTypeJob:
+		event = &JobEvent{}
 	case EventTypeMergeRequest:
 		event = &MergeEvent{}
 	case EventTypePipeline:
 		event = &PipelineEvent{}
 	case EventTypePush:
 		event = &PushEvent{}
+	case EventTypeRelease:
+		event = &ReleaseEvent{}
 	case EventTypeTagPush:
 		event = &TagEvent{}
 	case EventTypeWikiPage:
 		event = &WikiPageEvent{}
-	case EventTypeNote:
+	case EventTypeNote, EventTypeConfidentialNote:
 		note := &noteEvent{}
 		err := json.Unmarshal(payload, note)
 		if err != nil {
@@ -103,7 +128,7 @@ func ParseWebhook(eventType EventType, payload []byte) (event interface{}, err e
 		}
 
 	default:
-		return nil, fmt.Errorf("unexpected event type: %s", eventType)
+		return nil, unknownEventTypeError(eventType)
 	}
 
 	if err := json.Unmarshal(payload, event); err != nil {
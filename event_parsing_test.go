@@ -1240,3 +1240,408 @@ func TestParseBuildHook(t *testing.T) {
 		t.Errorf("Commit SHA is %v, want %v", event.Commit.SHA, "2293ada6b400935a1378653304eaf6221e0fdb8f")
 	}
 }
+
+func TestParseJobHook(t *testing.T) {
+	raw := `{
+  "object_kind": "build",
+  "ref": "gitlab-script-trigger",
+  "tag": false,
+  "before_sha": "2293ada6b400935a1378653304eaf6221e0fdb8f",
+  "sha": "2293ada6b400935a1378653304eaf6221e0fdb8f",
+  "build_id": 1977,
+  "build_name": "test",
+  "build_stage": "test",
+  "build_status": "failed",
+  "build_started_at": null,
+  "build_finished_at": null,
+  "build_duration": null,
+  "build_allow_failure": false,
+  "build_failure_reason": "script_failure",
+  "pipeline_id": 2366,
+  "project_id": 380,
+  "project_name": "gitlab-org/gitlab-test",
+  "user": {
+    "id": 3,
+    "name": "User",
+    "email": "user@gitlab.com",
+    "avatar_url": "http://www.gravatar.com/avatar/e32bd13e2add097461cb96824b7a829c?s=80&d=identicon"
+  },
+  "commit": {
+    "id": 2366,
+    "sha": "2293ada6b400935a1378653304eaf6221e0fdb8f",
+    "message": "test\n",
+    "author_name": "User",
+    "author_email": "user@gitlab.com",
+    "status": "created",
+    "duration": null,
+    "started_at": null,
+    "finished_at": null
+  },
+  "repository": {
+    "name": "gitlab_test",
+    "description": "Atque in sunt eos similique dolores voluptatem.",
+    "homepage": "http://192.168.64.1:3005/gitlab-org/gitlab-test",
+    "git_ssh_url": "git@192.168.64.1:gitlab-org/gitlab-test.git",
+    "git_http_url": "http://192.168.64.1:3005/gitlab-org/gitlab-test.git",
+    "visibility_level": 20
+  },
+  "runner": {
+    "active": true,
+    "is_shared": false,
+    "id": 380987,
+    "description": "shared-runners-manager-6.gitlab.com",
+    "tags": ["linux", "docker"]
+  },
+  "environment": null
+}`
+
+	parsedEvent, err := ParseWebhook("Job Hook", []byte(raw))
+	if err != nil {
+		t.Errorf("Error parsing job hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*JobEvent)
+	if !ok {
+		t.Errorf("Expected JobEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.BuildID != 1977 {
+		t.Errorf("BuildID is %v, want %v", event.BuildID, 1977)
+	}
+
+	if event.BuildFailureReason != "script_failure" {
+		t.Errorf("BuildFailureReason is %v, want %v", event.BuildFailureReason, "script_failure")
+	}
+
+	if event.PipelineID != 2366 {
+		t.Errorf("PipelineID is %v, want %v", event.PipelineID, 2366)
+	}
+
+	if event.Runner.ID != 380987 {
+		t.Errorf("Runner ID is %v, want %v", event.Runner.ID, 380987)
+	}
+}
+
+func TestParseDeploymentHook(t *testing.T) {
+	raw := `{
+  "object_kind": "deployment",
+  "status": "success",
+  "status_changed_at": "2021-04-28 21:50:00 +0200",
+  "deployment_id": 15,
+  "deployable_id": 796,
+  "deployable_url": "http://10.126.0.2:3000/root/test-deployment-webhooks/-/jobs/796",
+  "environment": "staging",
+  "project": {
+    "id": 30,
+    "name": "test-deployment-webhooks",
+    "description": "",
+    "web_url": "http://10.126.0.2:3000/root/test-deployment-webhooks",
+    "avatar_url": null,
+    "git_ssh_url": "ssh://vlad@10.126.0.2:2222/root/test-deployment-webhooks.git",
+    "git_http_url": "http://10.126.0.2:3000/root/test-deployment-webhooks.git",
+    "namespace": "Administrator",
+    "visibility_level": 0,
+    "path_with_namespace": "root/test-deployment-webhooks",
+    "default_branch": "master",
+    "ci_config_path": "",
+    "homepage": "http://10.126.0.2:3000/root/test-deployment-webhooks",
+    "url": "ssh://vlad@10.126.0.2:2222/root/test-deployment-webhooks.git",
+    "ssh_url": "ssh://vlad@10.126.0.2:2222/root/test-deployment-webhooks.git",
+    "http_url": "http://10.126.0.2:3000/root/test-deployment-webhooks.git"
+  },
+  "short_sha": "279484c0",
+  "user": {
+    "id": 1,
+    "name": "Administrator",
+    "username": "root",
+    "avatar_url": "https://www.gravatar.com/avatar/e64c7d89f26bd1972efa854d13d7dd61?s=80&d=identicon",
+    "email": "admin@example.com"
+  },
+  "user_url": "http://10.126.0.2:3000/root",
+  "commit_url": "http://10.126.0.2:3000/root/test-deployment-webhooks/-/commit/279484c09fbe69ededfced8c1bb6e6d24616b468",
+  "commit_title": "Add new file"
+}`
+
+	parsedEvent, err := ParseWebhook("Deployment Hook", []byte(raw))
+	if err != nil {
+		t.Errorf("Error parsing deployment hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*DeploymentEvent)
+	if !ok {
+		t.Errorf("Expected DeploymentEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.Status != "success" {
+		t.Errorf("Status is %v, want %v", event.Status, "success")
+	}
+
+	if event.DeploymentID != 15 {
+		t.Errorf("DeploymentID is %v, want %v", event.DeploymentID, 15)
+	}
+
+	if event.Environment != "staging" {
+		t.Errorf("Environment is %v, want %v", event.Environment, "staging")
+	}
+
+	if event.User.Username != "root" {
+		t.Errorf("User username is %v, want %v", event.User.Username, "root")
+	}
+}
+
+func TestParseReleaseHook(t *testing.T) {
+	raw := `{
+  "id": 1,
+  "created_at": "2020-11-02 12:55:12 UTC",
+  "description": "v1.0 has been released",
+  "name": "v1.1",
+  "released_at": "2020-11-02 12:55:12 UTC",
+  "tag": "v1.1",
+  "object_kind": "release",
+  "project": {
+    "id": 2,
+    "name": "release-webhook-example",
+    "description": "",
+    "web_url": "https://example.com/gitlab-org/release-webhook-example",
+    "avatar_url": null,
+    "git_ssh_url": "ssh://git@example.com/gitlab-org/release-webhook-example.git",
+    "git_http_url": "https://example.com/gitlab-org/release-webhook-example.git",
+    "namespace": "Gitlab",
+    "visibility_level": 0,
+    "path_with_namespace": "gitlab-org/release-webhook-example",
+    "default_branch": "master",
+    "ci_config_path": null,
+    "homepage": "https://example.com/gitlab-org/release-webhook-example",
+    "url": "ssh://git@example.com/gitlab-org/release-webhook-example.git",
+    "ssh_url": "ssh://git@example.com/gitlab-org/release-webhook-example.git",
+    "http_url": "https://example.com/gitlab-org/release-webhook-example.git"
+  },
+  "url": "https://example.com/gitlab-org/release-webhook-example/-/releases/v1.1",
+  "action": "create",
+  "assets": {
+    "count": 5,
+    "links": [
+      {
+        "id": 1,
+        "external": true,
+        "link_type": "other",
+        "name": "Changelog",
+        "url": "https://example.net/changelog"
+      }
+    ],
+    "sources": [
+      {
+        "format": "zip",
+        "url": "https://example.com/gitlab-org/release-webhook-example/-/archive/v1.1/release-webhook-example-v1.1.zip"
+      },
+      {
+        "format": "tar.gz",
+        "url": "https://example.com/gitlab-org/release-webhook-example/-/archive/v1.1/release-webhook-example-v1.1.tar.gz"
+      }
+    ]
+  },
+  "commit": {
+    "id": "ee0a3fb31ac16e11b9dbb596ad16d4af654d08f8",
+    "message": "Release v1.1",
+    "title": "Release v1.1",
+    "timestamp": "2020-10-31T14:58:32+11:00",
+    "url": "https://example.com/gitlab-org/release-webhook-example/-/commit/ee0a3fb31ac16e11b9dbb596ad16d4af654d08f8",
+    "author": {
+      "name": "Example User",
+      "email": "user@example.com"
+    }
+  }
+}`
+
+	parsedEvent, err := ParseWebhook("Release Hook", []byte(raw))
+	if err != nil {
+		t.Errorf("Error parsing release hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*ReleaseEvent)
+	if !ok {
+		t.Errorf("Expected ReleaseEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.Tag != "v1.1" {
+		t.Errorf("Tag is %v, want %v", event.Tag, "v1.1")
+	}
+
+	if event.Action != "create" {
+		t.Errorf("Action is %v, want %v", event.Action, "create")
+	}
+
+	if len(event.Assets.Links) != 1 || event.Assets.Links[0].LinkType != "other" {
+		t.Errorf("Assets links are %+v, want a single link of type %v", event.Assets.Links, "other")
+	}
+
+	if len(event.Assets.Sources) != 2 {
+		t.Errorf("Assets sources count is %v, want %v", len(event.Assets.Sources), 2)
+	}
+
+	if event.Commit.ID != "ee0a3fb31ac16e11b9dbb596ad16d4af654d08f8" {
+		t.Errorf("Commit ID is %v, want %v", event.Commit.ID, "ee0a3fb31ac16e11b9dbb596ad16d4af654d08f8")
+	}
+}
+
+func TestParseFeatureFlagHook(t *testing.T) {
+	raw := `{
+  "object_kind": "feature_flag",
+  "project": {
+    "id": 1,
+    "name": "Gitlab Test",
+    "description": "Aut reprehenderit ut est.",
+    "web_url": "http://example.com/gitlabhq/gitlab-test",
+    "avatar_url": null,
+    "git_ssh_url": "git@example.com:gitlabhq/gitlab-test.git",
+    "git_http_url": "http://example.com/gitlabhq/gitlab-test.git",
+    "namespace": "GitlabHQ",
+    "visibility_level": 20,
+    "path_with_namespace": "gitlabhq/gitlab-test",
+    "default_branch": "master",
+    "ci_config_path": null,
+    "homepage": "http://example.com/gitlabhq/gitlab-test",
+    "url": "http://example.com/gitlabhq/gitlab-test.git",
+    "ssh_url": "git@example.com:gitlabhq/gitlab-test.git",
+    "http_url": "http://example.com/gitlabhq/gitlab-test.git"
+  },
+  "user": {
+    "id": 1,
+    "name": "Administrator",
+    "username": "root",
+    "avatar_url": "https://www.gravatar.com/avatar/e64c7d89f26bd1972efa854d13d7dd61?s=80&d=identicon",
+    "email": "admin@example.com"
+  },
+  "user_url": "http://example.com/root",
+  "object_attributes": {
+    "id": 6,
+    "name": "test-feature-flag",
+    "description": "test-feature-flag-description",
+    "active": true
+  }
+}`
+
+	parsedEvent, err := ParseWebhook("Feature Flag Hook", []byte(raw))
+	if err != nil {
+		t.Errorf("Error parsing feature flag hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*FeatureFlagEvent)
+	if !ok {
+		t.Errorf("Expected FeatureFlagEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.ObjectKind != "feature_flag" {
+		t.Errorf("ObjectKind is %v, want %v", event.ObjectKind, "feature_flag")
+	}
+
+	if event.ObjectAttributes.Name != "test-feature-flag" {
+		t.Errorf("Name is %v, want %v", event.ObjectAttributes.Name, "test-feature-flag")
+	}
+
+	if !event.ObjectAttributes.Active {
+		t.Errorf("Active is %v, want %v", event.ObjectAttributes.Active, true)
+	}
+}
+
+func TestParseEmojiHook(t *testing.T) {
+	raw := `{
+  "object_kind": "emoji",
+  "event_type": "award",
+  "user": {
+    "id": 1,
+    "name": "Administrator",
+    "username": "root",
+    "avatar_url": "http://example.com/uploads/-/system/user/avatar/1/index.jpg",
+    "email": "admin@example.com"
+  },
+  "project_id": 6,
+  "project": {
+    "id": 6,
+    "name": "Flight",
+    "description": "Velit fugit aperiam illum deleniti odio sequi.",
+    "web_url": "http://example.com/flightjs/Flight",
+    "avatar_url": null,
+    "git_ssh_url": "ssh://git@example.com/flightjs/Flight.git",
+    "git_http_url": "http://example.com/flightjs/Flight.git",
+    "namespace": "Flightjs",
+    "visibility_level": 20,
+    "path_with_namespace": "flightjs/Flight",
+    "default_branch": "master",
+    "homepage": "http://example.com/flightjs/Flight",
+    "url": "ssh://git@example.com/flightjs/Flight.git",
+    "ssh_url": "ssh://git@example.com/flightjs/Flight.git",
+    "http_url": "http://example.com/flightjs/Flight.git"
+  },
+  "object_attributes": {
+    "user_id": 1,
+    "created_at": "2023-07-04 20:44:11 UTC",
+    "id": 1,
+    "name": "thumbsup",
+    "awardable_type": "Issue",
+    "awardable_id": 23,
+    "updated_at": "2023-07-04 20:44:11 UTC",
+    "awarded_on_url": "http://example.com/flightjs/Flight/-/issues/1"
+  }
+}`
+
+	parsedEvent, err := ParseWebhook("Emoji Hook", []byte(raw))
+	if err != nil {
+		t.Errorf("Error parsing emoji hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*EmojiEvent)
+	if !ok {
+		t.Errorf("Expected EmojiEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if event.ObjectAttributes.Name != "thumbsup" {
+		t.Errorf("Name is %v, want %v", event.ObjectAttributes.Name, "thumbsup")
+	}
+
+	if event.ObjectAttributes.AwardableType != "Issue" {
+		t.Errorf("AwardableType is %v, want %v", event.ObjectAttributes.AwardableType, "Issue")
+	}
+}
+
+func TestParseConfidentialIssueHook(t *testing.T) {
+	raw := `{
+  "object_kind": "issue",
+  "user": {
+    "name": "Administrator",
+    "username": "root",
+    "avatar_url": "http://www.gravatar.com/avatar/e64c7d89f26bd1972efa854d13d7dd61?s=40&d=identicon"
+  },
+  "object_attributes": {
+    "id": 301,
+    "title": "New API: create/update/delete file",
+    "confidential": true,
+    "iid": 23,
+    "action": "open"
+  },
+  "labels": [{
+    "id": 206,
+    "title": "API",
+    "color": "#ffffff",
+    "description": "API related issues"
+  }]
+}`
+
+	parsedEvent, err := ParseWebhook("Confidential Issue Hook", []byte(raw))
+	if err != nil {
+		t.Errorf("Error parsing confidential issue hook: %s", err)
+	}
+
+	event, ok := parsedEvent.(*IssueEvent)
+	if !ok {
+		t.Errorf("Expected IssueEvent, but parsing produced %T", parsedEvent)
+	}
+
+	if !event.ObjectAttributes.Confidential {
+		t.Errorf("Confidential is %v, want %v", event.ObjectAttributes.Confidential, true)
+	}
+
+	if len(event.Labels) != 1 || event.Labels[0].Name != "API" {
+		t.Errorf("Labels are %+v, want a single label named %v", event.Labels, "API")
+	}
+}
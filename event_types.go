@@ -140,27 +140,34 @@ type IssueEvent struct {
 	} `json:"project"`
 	Repository       *Repository `json:"repository"`
 	ObjectAttributes struct {
-		ID          int    `json:"id"`
-		Title       string `json:"title"`
-		AssigneeID  int    `json:"assignee_id"`
-		AuthorID    int    `json:"author_id"`
-		ProjectID   int    `json:"project_id"`
-		CreatedAt   string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
-		UpdatedAt   string `json:"updated_at"` // Should be *time.Time (see Gitlab issue #21468)
-		Position    int    `json:"position"`
-		BranchName  string `json:"branch_name"`
-		Description string `json:"description"`
-		MilestoneID int    `json:"milestone_id"`
-		State       string `json:"state"`
-		IID         int    `json:"iid"`
-		URL         string `json:"url"`
-		Action      string `json:"action"`
+		ID           int    `json:"id"`
+		Title        string `json:"title"`
+		AssigneeID   int    `json:"assignee_id"`
+		AuthorID     int    `json:"author_id"`
+		ProjectID    int    `json:"project_id"`
+		CreatedAt    string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
+		UpdatedAt    string `json:"updated_at"` // Should be *time.Time (see Gitlab issue #21468)
+		Position     int    `json:"position"`
+		BranchName   string `json:"branch_name"`
+		Description  string `json:"description"`
+		MilestoneID  int    `json:"milestone_id"`
+		State        string `json:"state"`
+		IID          int    `json:"iid"`
+		URL          string `json:"url"`
+		Action       string `json:"action"`
+		Confidential bool   `json:"confidential"`
 	} `json:"object_attributes"`
 	Assignee struct {
 		Name      string `json:"name"`
 		Username  string `json:"username"`
 		AvatarURL string `json:"avatar_url"`
 	} `json:"assignee"`
+	Assignees []struct {
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"assignees"`
+	Labels []Label `json:"labels"`
 }
 
 // CommitCommentEvent represents a comment on a commit event.
@@ -668,3 +675,243 @@ type BuildEvent struct {
 	} `json:"commit"`
 	Repository *Repository `json:"repository"`
 }
+
+// JobEvent represents a job event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#job-events
+type JobEvent struct {
+	ObjectKind         string  `json:"object_kind"`
+	Ref                string  `json:"ref"`
+	Tag                bool    `json:"tag"`
+	BeforeSHA          string  `json:"before_sha"`
+	SHA                string  `json:"sha"`
+	BuildID            int     `json:"build_id"`
+	BuildName          string  `json:"build_name"`
+	BuildStage         string  `json:"build_stage"`
+	BuildStatus        string  `json:"build_status"`
+	BuildStartedAt     string  `json:"build_started_at"`
+	BuildFinishedAt    string  `json:"build_finished_at"`
+	BuildDuration      float64 `json:"build_duration"`
+	BuildAllowFailure  bool    `json:"build_allow_failure"`
+	BuildFailureReason string  `json:"build_failure_reason"`
+	PipelineID         int     `json:"pipeline_id"`
+	ProjectID          int     `json:"project_id"`
+	ProjectName        string  `json:"project_name"`
+	User               struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	Commit struct {
+		ID          int    `json:"id"`
+		SHA         string `json:"sha"`
+		Message     string `json:"message"`
+		AuthorName  string `json:"author_name"`
+		AuthorEmail string `json:"author_email"`
+		AuthorURL   string `json:"author_url"`
+		Status      string `json:"status"`
+		Duration    int    `json:"duration"`
+		StartedAt   string `json:"started_at"`
+		FinishedAt  string `json:"finished_at"`
+	} `json:"commit"`
+	Repository *Repository `json:"repository"`
+	Runner     struct {
+		ID          int      `json:"id"`
+		Description string   `json:"description"`
+		Active      bool     `json:"active"`
+		IsShared    bool     `json:"is_shared"`
+		Tags        []string `json:"tags"`
+	} `json:"runner"`
+	Environment struct {
+		Name   string `json:"name"`
+		Action string `json:"action"`
+	} `json:"environment"`
+}
+
+// DeploymentEvent represents a deployment event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#deployment-events
+type DeploymentEvent struct {
+	ObjectKind      string `json:"object_kind"`
+	Status          string `json:"status"`
+	StatusChangedAt string `json:"status_changed_at"`
+	DeploymentID    int    `json:"deployment_id"`
+	DeployableID    int    `json:"deployable_id"`
+	DeployableURL   string `json:"deployable_url"`
+	Environment     string `json:"environment"`
+	Project         struct {
+		ID                int             `json:"id"`
+		Name              string          `json:"name"`
+		Description       string          `json:"description"`
+		AvatarURL         string          `json:"avatar_url"`
+		GitSSHURL         string          `json:"git_ssh_url"`
+		GitHTTPURL        string          `json:"git_http_url"`
+		Namespace         string          `json:"namespace"`
+		PathWithNamespace string          `json:"path_with_namespace"`
+		DefaultBranch     string          `json:"default_branch"`
+		Homepage          string          `json:"homepage"`
+		URL               string          `json:"url"`
+		SSHURL            string          `json:"ssh_url"`
+		HTTPURL           string          `json:"http_url"`
+		WebURL            string          `json:"web_url"`
+		Visibility        VisibilityValue `json:"visibility"`
+	} `json:"project"`
+	Ref      string `json:"ref"`
+	ShortSHA string `json:"short_sha"`
+	User     struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	UserURL     string `json:"user_url"`
+	CommitURL   string `json:"commit_url"`
+	CommitTitle string `json:"commit_title"`
+}
+
+// ReleaseEvent represents a release event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#release-events
+type ReleaseEvent struct {
+	ID          int    `json:"id"`
+	CreatedAt   string `json:"created_at"` // Should be *time.Time (see Gitlab issue #21468)
+	Description string `json:"description"`
+	Name        string `json:"name"`
+	ReleasedAt  string `json:"released_at"`
+	Tag         string `json:"tag"`
+	ObjectKind  string `json:"object_kind"`
+	Project     struct {
+		ID                int             `json:"id"`
+		Name              string          `json:"name"`
+		Description       string          `json:"description"`
+		AvatarURL         string          `json:"avatar_url"`
+		GitSSHURL         string          `json:"git_ssh_url"`
+		GitHTTPURL        string          `json:"git_http_url"`
+		Namespace         string          `json:"namespace"`
+		PathWithNamespace string          `json:"path_with_namespace"`
+		DefaultBranch     string          `json:"default_branch"`
+		Homepage          string          `json:"homepage"`
+		URL               string          `json:"url"`
+		SSHURL            string          `json:"ssh_url"`
+		HTTPURL           string          `json:"http_url"`
+		WebURL            string          `json:"web_url"`
+		Visibility        VisibilityValue `json:"visibility"`
+	} `json:"project"`
+	URL    string `json:"url"`
+	Action string `json:"action"`
+	Assets struct {
+		Count int `json:"count"`
+		Links []struct {
+			ID       int    `json:"id"`
+			External bool   `json:"external"`
+			LinkType string `json:"link_type"`
+			Name     string `json:"name"`
+			URL      string `json:"url"`
+		} `json:"links"`
+		Sources []struct {
+			Format string `json:"format"`
+			URL    string `json:"url"`
+		} `json:"sources"`
+	} `json:"assets"`
+	Commit struct {
+		ID        string     `json:"id"`
+		Message   string     `json:"message"`
+		Title     string     `json:"title"`
+		Timestamp *time.Time `json:"timestamp"`
+		URL       string     `json:"url"`
+		Author    struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"author"`
+	} `json:"commit"`
+}
+
+// FeatureFlagEvent represents a feature flag event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#feature-flag-events
+type FeatureFlagEvent struct {
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		ID                int             `json:"id"`
+		Name              string          `json:"name"`
+		Description       string          `json:"description"`
+		AvatarURL         string          `json:"avatar_url"`
+		GitSSHURL         string          `json:"git_ssh_url"`
+		GitHTTPURL        string          `json:"git_http_url"`
+		Namespace         string          `json:"namespace"`
+		PathWithNamespace string          `json:"path_with_namespace"`
+		DefaultBranch     string          `json:"default_branch"`
+		Homepage          string          `json:"homepage"`
+		URL               string          `json:"url"`
+		SSHURL            string          `json:"ssh_url"`
+		HTTPURL           string          `json:"http_url"`
+		WebURL            string          `json:"web_url"`
+		Visibility        VisibilityValue `json:"visibility"`
+	} `json:"project"`
+	User struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	UserURL          string `json:"user_url"`
+	ObjectAttributes struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Active      bool   `json:"active"`
+	} `json:"object_attributes"`
+}
+
+// EmojiEvent represents an emoji event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/user/project/integrations/webhooks.html#emoji-events
+type EmojiEvent struct {
+	ObjectKind string `json:"object_kind"`
+	EventType  string `json:"event_type"`
+	User       struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+		Email     string `json:"email"`
+	} `json:"user"`
+	ProjectID int `json:"project_id"`
+	Project   struct {
+		ID                int             `json:"id"`
+		Name              string          `json:"name"`
+		Description       string          `json:"description"`
+		AvatarURL         string          `json:"avatar_url"`
+		GitSSHURL         string          `json:"git_ssh_url"`
+		GitHTTPURL        string          `json:"git_http_url"`
+		Namespace         string          `json:"namespace"`
+		PathWithNamespace string          `json:"path_with_namespace"`
+		DefaultBranch     string          `json:"default_branch"`
+		Homepage          string          `json:"homepage"`
+		URL               string          `json:"url"`
+		SSHURL            string          `json:"ssh_url"`
+		HTTPURL           string          `json:"http_url"`
+		WebURL            string          `json:"web_url"`
+		Visibility        VisibilityValue `json:"visibility"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		UserID        int    `json:"user_id"`
+		CreatedAt     string `json:"created_at"`
+		ID            int    `json:"id"`
+		Name          string `json:"name"`
+		AwardableType string `json:"awardable_type"`
+		AwardableID   int    `json:"awardable_id"`
+		UpdatedAt     string `json:"updated_at"`
+		AwardedOnURL  string `json:"awarded_on_url"`
+	} `json:"object_attributes"`
+	Repository *Repository `json:"repository"`
+}
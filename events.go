@@ -17,8 +17,8 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -35,6 +35,7 @@ type EventsService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/events.html#get-user-contribution-events
 type ContributionEvent struct {
+	ID          int        `json:"id"`
 	Title       string     `json:"title"`
 	ProjectID   int        `json:"project_id"`
 	ActionName  string     `json:"action_name"`
@@ -130,7 +131,7 @@ func (s *EventsService) ListProjectVisibleEvents(pid interface{}, opt *ListContr
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/events", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/events", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -145,3 +146,152 @@ func (s *EventsService) ListProjectVisibleEvents(pid interface{}, opt *ListContr
 
 	return cs, resp, err
 }
+
+// PollProjectEvents periodically polls the visible events of a project and
+// delivers new events to the returned channel, oldest first. Events are
+// deduplicated, so every event is delivered only once.
+//
+// If opt.After is not set, only events created after polling started are
+// delivered. Errors that occur while polling are delivered to the returned
+// error channel, after which polling continues. Receiving the errors is
+// optional: an error is dropped when the previous one was not received yet,
+// so polling never blocks on the error channel. Both channels are closed when
+// ctx is done.
+func (s *EventsService) PollProjectEvents(ctx context.Context, pid interface{}, interval time.Duration, opt *ListContributionEventsOptions, options ...OptionFunc) (<-chan *ContributionEvent, <-chan error) {
+	return pollEvents(ctx, interval, opt, func(opt *ListContributionEventsOptions) ([]*ContributionEvent, *Response, error) {
+		return s.ListProjectVisibleEvents(pid, opt, append(options[:len(options):len(options)], WithContext(ctx))...)
+	})
+}
+
+// PollCurrentUserContributionEvents periodically polls the events of the
+// currently authenticated user and delivers new events to the returned
+// channel. See PollProjectEvents for details.
+func (s *EventsService) PollCurrentUserContributionEvents(ctx context.Context, interval time.Duration, opt *ListContributionEventsOptions, options ...OptionFunc) (<-chan *ContributionEvent, <-chan error) {
+	return pollEvents(ctx, interval, opt, func(opt *ListContributionEventsOptions) ([]*ContributionEvent, *Response, error) {
+		return s.ListCurrentUserContributionEvents(opt, append(options[:len(options):len(options)], WithContext(ctx))...)
+	})
+}
+
+type listEventsFunc func(opt *ListContributionEventsOptions) ([]*ContributionEvent, *Response, error)
+
+func pollEvents(ctx context.Context, interval time.Duration, opt *ListContributionEventsOptions, list listEventsFunc) (<-chan *ContributionEvent, <-chan error) {
+	events := make(chan *ContributionEvent)
+	errs := make(chan error, 1)
+
+	o := ListContributionEventsOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	o.Sort = String("asc")
+
+	// The after parameter only has day granularity and is exclusive, so
+	// the events of the starting day are fetched as well and deduplicated.
+	var after time.Time
+	baseline := o.After == nil
+	if baseline {
+		after = time.Now().AddDate(0, 0, -1)
+	} else {
+		after = time.Time(*o.After)
+	}
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		// Keeps track of the delivered events and when they were created.
+		seen := make(map[string]time.Time)
+
+		for {
+			o.After = (*ISOTime)(&after)
+			o.Page = 0
+
+			var fetched []*ContributionEvent
+			var err error
+			for {
+				var es []*ContributionEvent
+				var resp *Response
+				es, resp, err = list(&o)
+				if err != nil {
+					break
+				}
+				fetched = append(fetched, es...)
+				if resp.NextPage == 0 {
+					break
+				}
+				o.Page = resp.NextPage
+			}
+
+			// A partial fetch is discarded, so a failed first fetch does not
+			// become the baseline and events are never skipped.
+			if err != nil {
+				select {
+				case errs <- err:
+				default:
+				}
+
+				select {
+				case <-time.After(interval):
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			for _, e := range fetched {
+				key := eventKey(e)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+
+				var created time.Time
+				if e.CreatedAt != nil {
+					created = *e.CreatedAt
+				}
+				seen[key] = created
+
+				if created.AddDate(0, 0, -1).After(after) {
+					after = created.AddDate(0, 0, -1)
+				}
+
+				if baseline {
+					continue
+				}
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+			baseline = false
+
+			// Forget events that can no longer be returned.
+			for key, created := range seen {
+				if created.Before(after) {
+					delete(seen, key)
+				}
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, errs
+}
+
+// eventKey returns a key that uniquely identifies an event.
+func eventKey(e *ContributionEvent) string {
+	if e.ID != 0 {
+		return fmt.Sprintf("%d", e.ID)
+	}
+
+	var created string
+	if e.CreatedAt != nil {
+		created = e.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return fmt.Sprintf("%d/%s/%s/%d/%d/%s", e.ProjectID, e.ActionName, e.TargetType, e.TargetID, e.AuthorID, created)
+}
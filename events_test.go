@@ -0,0 +1,154 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPollProjectEvents(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	var mu sync.Mutex
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("sort") != "asc" {
+			t.Errorf("Sort is %q, want %q", r.URL.Query().Get("sort"), "asc")
+		}
+
+		mu.Lock()
+		calls++
+		c := calls
+		mu.Unlock()
+
+		switch c {
+		case 1:
+			fmt.Fprintf(w, `[{"id": 1, "created_at": %q}]`, now)
+		default:
+			fmt.Fprintf(w, `[{"id": 1, "created_at": %q}, {"id": 2, "created_at": %q}]`, now, now)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, errs := client.Events.PollProjectEvents(ctx, 1, 10*time.Millisecond, nil)
+
+	select {
+	case e := <-events:
+		if e.ID != 2 {
+			t.Errorf("Received event %d, want %d", e.ID, 2)
+		}
+	case err := <-errs:
+		t.Fatalf("Events.PollProjectEvents returned error: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+
+	select {
+	case e := <-events:
+		t.Errorf("Received unexpected event %d", e.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestPollProjectEventsFirstPollFails(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	var mu sync.Mutex
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/events", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		c := calls
+		mu.Unlock()
+
+		switch c {
+		case 1:
+			w.WriteHeader(http.StatusInternalServerError)
+		case 2:
+			// Event 1 already existed when polling started.
+			fmt.Fprintf(w, `[{"id": 1, "created_at": %q}]`, now)
+		default:
+			fmt.Fprintf(w, `[{"id": 1, "created_at": %q}, {"id": 2, "created_at": %q}]`, now, now)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, errs := client.Events.PollProjectEvents(ctx, 1, 10*time.Millisecond, nil)
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, ErrServerError) {
+			t.Errorf("Received error %v, want a server error", err)
+		}
+	case e := <-events:
+		t.Fatalf("Received event %d before the error", e.ID)
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for error")
+	}
+
+	select {
+	case e := <-events:
+		if e.ID != 2 {
+			t.Errorf("Received event %d, want %d", e.ID, 2)
+		}
+	case err := <-errs:
+		t.Fatalf("Events.PollProjectEvents returned error: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+}
+
+func TestPollProjectEventsIgnoredErrors(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	var mu sync.Mutex
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/events", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		c := calls
+		mu.Unlock()
+
+		switch {
+		case c <= 3:
+			w.WriteHeader(http.StatusInternalServerError)
+		case c == 4:
+			fmt.Fprintf(w, `[{"id": 1, "created_at": %q}]`, now)
+		default:
+			fmt.Fprintf(w, `[{"id": 1, "created_at": %q}, {"id": 2, "created_at": %q}]`, now, now)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The errors are never received, which must not stop the events.
+	events, _ := client.Events.PollProjectEvents(ctx, 1, 10*time.Millisecond, nil)
+
+	select {
+	case e := <-events:
+		if e.ID != 2 {
+			t.Errorf("Received event %d, want %d", e.ID, 2)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+}
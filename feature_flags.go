@@ -2,7 +2,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // FeaturesService handles the communication with the application FeaturesService
@@ -57,7 +56,7 @@ func (s *FeaturesService) ListFeatures(options ...OptionFunc) ([]*Feature, *Resp
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/features.html#set-or-create-a-feature
 func (s *FeaturesService) SetFeatureFlag(name string, value interface{}, options ...OptionFunc) (*Feature, *Response, error) {
-	u := fmt.Sprintf("features/%s", url.QueryEscape(name))
+	u := fmt.Sprintf("features/%s", pathEscape(name))
 
 	opt := struct {
 		Value interface{} `url:"value" json:"value"`
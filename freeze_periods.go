@@ -0,0 +1,193 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// FreezePeriodsService handles communication with the deploy freeze period
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/freeze_periods.html
+type FreezePeriodsService struct {
+	client *Client
+}
+
+// FreezePeriod represents a deploy freeze period of a project. FreezeStart
+// and FreezeEnd are cron expressions evaluated in CronTimezone.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/freeze_periods.html
+type FreezePeriod struct {
+	ID           int        `json:"id"`
+	FreezeStart  string     `json:"freeze_start"`
+	FreezeEnd    string     `json:"freeze_end"`
+	CronTimezone string     `json:"cron_timezone"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
+
+func (f FreezePeriod) String() string {
+	return Stringify(f)
+}
+
+// Active reports whether the freeze period is in effect at t, meaning the
+// start schedule ran more recently than the end schedule.
+func (f *FreezePeriod) Active(t time.Time) (bool, error) {
+	start, err := parseCron(f.FreezeStart)
+	if err != nil {
+		return false, err
+	}
+	end, err := parseCron(f.FreezeEnd)
+	if err != nil {
+		return false, err
+	}
+
+	loc := time.UTC
+	if f.CronTimezone != "" {
+		if loc, err = time.LoadLocation(f.CronTimezone); err != nil {
+			return false, err
+		}
+	}
+	t = t.In(loc)
+
+	lastStart, ok := start.prev(t)
+	if !ok {
+		return false, nil
+	}
+	lastEnd, ok := end.prev(t)
+	if !ok {
+		return true, nil
+	}
+	return lastStart.After(lastEnd), nil
+}
+
+// DeploymentFrozen reports whether any of the given freeze periods is in
+// effect at t.
+func DeploymentFrozen(periods []*FreezePeriod, t time.Time) (bool, error) {
+	for _, p := range periods {
+		active, err := p.Active(t)
+		if err != nil {
+			return false, fmt.Errorf("freeze period %d: %v", p.ID, err)
+		}
+		if active {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListFreezePeriodsOptions represents the available ListFreezePeriods()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/freeze_periods.html#list-freeze-periods
+type ListFreezePeriodsOptions struct {
+	ListOptions
+}
+
+// ListFreezePeriods gets a list of the freeze periods of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/freeze_periods.html#list-freeze-periods
+func (s *FreezePeriodsService) ListFreezePeriods(pid interface{}, opt *ListFreezePeriodsOptions, options ...OptionFunc) ([]*FreezePeriod, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/freeze_periods", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fs []*FreezePeriod
+	resp, err := s.client.Do(req, &fs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fs, resp, err
+}
+
+// GetFreezePeriod gets a single freeze period of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/freeze_periods.html#get-a-freeze-period-by-a-freeze_period_id
+func (s *FreezePeriodsService) GetFreezePeriod(pid interface{}, freezePeriod int, options ...OptionFunc) (*FreezePeriod, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/freeze_periods/%d", pathEscape(project), freezePeriod)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f := new(FreezePeriod)
+	resp, err := s.client.Do(req, f)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return f, resp, err
+}
+
+// listAllFreezePeriods gets all freeze periods of a project, following the
+// pagination.
+func (s *FreezePeriodsService) listAllFreezePeriods(pid interface{}, options ...OptionFunc) ([]*FreezePeriod, error) {
+	opt := &ListFreezePeriodsOptions{ListOptions: ListOptions{PerPage: 100}}
+
+	var all []*FreezePeriod
+	err := Paginate(&opt.ListOptions, func() (*Response, error) {
+		fs, resp, err := s.ListFreezePeriods(pid, opt, options...)
+		all = append(all, fs...)
+		return resp, err
+	})
+	return all, err
+}
+
+// IsDeploymentFrozen reports whether deployments of a project are frozen at
+// t by any of its freeze periods. Pass time.Now() to check whether a
+// deployment may happen right now.
+func (s *FreezePeriodsService) IsDeploymentFrozen(pid interface{}, t time.Time, options ...OptionFunc) (bool, error) {
+	fs, err := s.listAllFreezePeriods(pid, options...)
+	if err != nil {
+		return false, err
+	}
+	return DeploymentFrozen(fs, t)
+}
+
+// ListGroupFreezePeriods gets the freeze periods of all projects of a group,
+// keyed by project ID. Projects without freeze periods are left out. The
+// options select the projects of the group, and are used to page through
+// them.
+func (s *FreezePeriodsService) ListGroupFreezePeriods(gid interface{}, opt *ListGroupProjectsOptions, options ...OptionFunc) (map[int][]*FreezePeriod, error) {
+	if opt == nil {
+		opt = &ListGroupProjectsOptions{ListOptions: ListOptions{PerPage: 100}}
+	}
+
+	var projects []*Project
+	err := Paginate(&opt.ListOptions, func() (*Response, error) {
+		ps, resp, err := s.client.Groups.ListGroupProjects(gid, opt, options...)
+		projects = append(projects, ps...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make(map[int][]*FreezePeriod)
+	for _, p := range projects {
+		fs, err := s.listAllFreezePeriods(p.ID, options...)
+		if err != nil {
+			return nil, err
+		}
+		if len(fs) > 0 {
+			periods[p.ID] = fs
+		}
+	}
+	return periods, nil
+}
@@ -0,0 +1,100 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFreezePeriodActive(t *testing.T) {
+	// Frozen from Friday 23:00 until Monday 07:00, Berlin time.
+	weekend := &FreezePeriod{FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "Europe/Berlin"}
+	// Frozen during the whole of December.
+	december := &FreezePeriod{FreezeStart: "0 0 1 dec *", FreezeEnd: "0 0 1 jan *"}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+
+	tests := []struct {
+		period *FreezePeriod
+		at     time.Time
+		want   bool
+	}{
+		{weekend, time.Date(2026, time.October, 16, 22, 59, 0, 0, berlin), false},
+		{weekend, time.Date(2026, time.October, 16, 23, 0, 0, 0, berlin), true},
+		{weekend, time.Date(2026, time.October, 18, 12, 0, 0, 0, berlin), true},
+		{weekend, time.Date(2026, time.October, 19, 5, 0, 0, 0, time.UTC), false},
+		{weekend, time.Date(2026, time.October, 19, 4, 59, 0, 0, time.UTC), true},
+		{december, time.Date(2026, time.December, 24, 12, 0, 0, 0, time.UTC), true},
+		{december, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), false},
+		{december, time.Date(2026, time.November, 30, 23, 59, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.period.Active(tt.at)
+		if err != nil {
+			t.Fatalf("FreezePeriod.Active returned error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("FreezePeriod{%s, %s}.Active(%s) = %t, want %t", tt.period.FreezeStart, tt.period.FreezeEnd, tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+		if _, err := parseCron(expr); err == nil {
+			t.Errorf("parseCron(%q) returned no error", expr)
+		}
+	}
+}
+
+func TestIsDeploymentFrozen(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "freeze_start": "0 23 * * 5", "freeze_end": "0 7 * * 1", "cron_timezone": "UTC"}]`)
+	})
+
+	frozen, err := client.FreezePeriods.IsDeploymentFrozen(1, time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("FreezePeriods.IsDeploymentFrozen returned error: %v", err)
+	}
+	if !frozen {
+		t.Error("FreezePeriods.IsDeploymentFrozen returned false, want true")
+	}
+}
+
+func TestListGroupFreezePeriods(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/5/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 3, "freeze_start": "0 23 * * 5", "freeze_end": "0 7 * * 1"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[]`)
+	})
+
+	periods, err := client.FreezePeriods.ListGroupFreezePeriods(5, nil)
+	if err != nil {
+		t.Fatalf("FreezePeriods.ListGroupFreezePeriods returned error: %v", err)
+	}
+
+	want := map[int][]*FreezePeriod{1: {{ID: 3, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1"}}}
+	if !reflect.DeepEqual(want, periods) {
+		t.Errorf("FreezePeriods.ListGroupFreezePeriods returned %+v, want %+v", periods, want)
+	}
+}
@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // GitIgnoreTemplatesService handles communication with the gitignore
@@ -67,7 +66,7 @@ func (s *GitIgnoreTemplatesService) ListTemplates(opt *ListTemplatesOptions, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/templates/gitignores.html#single-gitignore-template
 func (s *GitIgnoreTemplatesService) GetTemplate(key string, options ...OptionFunc) (*GitIgnoreTemplate, *Response, error) {
-	u := fmt.Sprintf("templates/gitignores/%s", url.QueryEscape(key))
+	u := fmt.Sprintf("templates/gitignores/%s", pathEscape(key))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
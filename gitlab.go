@@ -24,8 +24,10 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -53,6 +55,7 @@ const (
 	basicAuth authType = iota
 	oAuthToken
 	privateToken
+	jobToken
 )
 
 // AccessLevelValue represents a permission level within GitLab.
@@ -76,6 +79,21 @@ const (
 	OwnerPermission   AccessLevelValue = 50
 )
 
+// BranchProtectionValue represents the default branch protection level of a
+// group.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/groups.html#options-for-default_branch_protection
+type BranchProtectionValue int
+
+// List of available branch protection levels.
+const (
+	NoBranchProtection BranchProtectionValue = iota
+	DeveloperCanPushBranchProtection
+	FullBranchProtection
+	DeveloperCanMergeBranchProtection
+	InitialPushBranchProtection
+)
+
 // BuildStateValue represents a GitLab build state.
 type BuildStateValue string
 
@@ -89,6 +107,17 @@ const (
 	Skipped  BuildStateValue = "skipped"
 )
 
+// VariableTypeValue represents the type of a CI/CD variable.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/ci/variables/#cicd-variable-types
+type VariableTypeValue string
+
+// List of available variable types.
+const (
+	EnvVariableType  VariableTypeValue = "env_var"
+	FileVariableType VariableTypeValue = "file"
+)
+
 // ISOTime represents an ISO 8601 formatted date
 type ISOTime time.Time
 
@@ -227,6 +256,53 @@ const (
 	RebaseMerge        MergeMethodValue = "rebase_merge"
 )
 
+// AccessControlValue represents the access level of a project feature.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+type AccessControlValue string
+
+// List of available access control values
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+const (
+	DisabledAccessControl AccessControlValue = "disabled"
+	PrivateAccessControl  AccessControlValue = "private"
+	EnabledAccessControl  AccessControlValue = "enabled"
+	PublicAccessControl   AccessControlValue = "public"
+)
+
+// SquashOptionValue represents the squash option of a project.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+type SquashOptionValue string
+
+// List of available squash options
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#edit-project
+const (
+	SquashOptionNever      SquashOptionValue = "never"
+	SquashOptionAlways     SquashOptionValue = "always"
+	SquashOptionDefaultOn  SquashOptionValue = "default_on"
+	SquashOptionDefaultOff SquashOptionValue = "default_off"
+)
+
+// SharedRunnersSettingValue represents whether the shared runners of a group
+// are enabled, and whether its subgroups and projects can override that.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#options-for-shared_runners_setting
+type SharedRunnersSettingValue string
+
+// List of available shared runners settings
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/groups.html#options-for-shared_runners_setting
+const (
+	EnabledSharedRunnersSetting                  SharedRunnersSettingValue = "enabled"
+	DisabledAndOverridableSharedRunnersSetting   SharedRunnersSettingValue = "disabled_and_overridable"
+	DisabledAndUnoverridableSharedRunnersSetting SharedRunnersSettingValue = "disabled_and_unoverridable"
+)
+
 // EventTypeValue represents actions type for contribution events
 type EventTypeValue string
 
@@ -282,70 +358,120 @@ type Client struct {
 	// Token used to make authenticated API calls.
 	token string
 
+	// Source of the OAuth tokens used to make authenticated API calls, if
+	// any. See NewOAuthTokenSourceClient.
+	tokenSource oauth2.TokenSource
+
 	// User agent used when communicating with the GitLab API.
 	UserAgent string
 
+	// Maximum number of retries and the maximum time to wait before a retry
+	// when a request is rate limited. See WithRateLimitRetry.
+	rateLimitRetries int
+	rateLimitMaxWait time.Duration
+
+	// Maximum number of retries and the backoff used when a request fails
+	// with a transient error. See WithRetry.
+	retryMax     int
+	retryBackoff BackoffFunc
+
+	// Tokens to rotate between. See WithTokenRotation.
+	tokens *tokenPool
+
+	// User to impersonate on all requests. See WithDefaultSudo.
+	sudo interface{}
+
+	// Called for responses of deprecated endpoints. See WithDeprecationHandler.
+	deprecationHandler func(*Response)
+
+	// Writer to log mutating requests to instead of sending them. See
+	// WithDryRun.
+	dryRun io.Writer
+
 	// Services used for talking to different parts of the GitLab API.
-	AccessRequests        *AccessRequestsService
-	AwardEmoji            *AwardEmojiService
-	Branches              *BranchesService
-	BuildVariables        *BuildVariablesService
-	BroadcastMessage      *BroadcastMessagesService
-	CIYMLTemplate         *CIYMLTemplatesService
-	Commits               *CommitsService
-	CustomAttribute       *CustomAttributesService
-	DeployKeys            *DeployKeysService
-	Deployments           *DeploymentsService
-	Discussions           *DiscussionsService
-	Environments          *EnvironmentsService
-	Events                *EventsService
-	Features              *FeaturesService
-	GitIgnoreTemplates    *GitIgnoreTemplatesService
-	Groups                *GroupsService
-	GroupIssueBoards      *GroupIssueBoardsService
-	GroupMembers          *GroupMembersService
-	GroupMilestones       *GroupMilestonesService
-	GroupVariables        *GroupVariablesService
-	Issues                *IssuesService
-	IssueLinks            *IssueLinksService
-	Jobs                  *JobsService
-	Keys                  *KeysService
-	Boards                *IssueBoardsService
-	Labels                *LabelsService
-	License               *LicenseService
-	LicenseTemplates      *LicenseTemplatesService
-	MergeRequests         *MergeRequestsService
-	MergeRequestApprovals *MergeRequestApprovalsService
-	Milestones            *MilestonesService
-	Namespaces            *NamespacesService
-	Notes                 *NotesService
-	NotificationSettings  *NotificationSettingsService
-	PagesDomains          *PagesDomainsService
-	Pipelines             *PipelinesService
-	PipelineSchedules     *PipelineSchedulesService
-	PipelineTriggers      *PipelineTriggersService
-	Projects              *ProjectsService
-	ProjectMembers        *ProjectMembersService
-	ProjectBadges         *ProjectBadgesService
-	ProjectSnippets       *ProjectSnippetsService
-	ProjectVariables      *ProjectVariablesService
-	ProtectedBranches     *ProtectedBranchesService
-	ProtectedTags         *ProtectedTagsService
-	Repositories          *RepositoriesService
-	RepositoryFiles       *RepositoryFilesService
-	Runners               *RunnersService
-	Search                *SearchService
-	Services              *ServicesService
-	Settings              *SettingsService
-	Sidekiq               *SidekiqService
-	Snippets              *SnippetsService
-	SystemHooks           *SystemHooksService
-	Tags                  *TagsService
-	Todos                 *TodosService
-	Users                 *UsersService
-	Validate              *ValidateService
-	Version               *VersionService
-	Wikis                 *WikisService
+	AccessRequests              *AccessRequestsService
+	AuditEventStreaming         *AuditEventStreamingService
+	AwardEmoji                  *AwardEmojiService
+	BatchedBackgroundMigrations *BatchedBackgroundMigrationsService
+	Branches                    *BranchesService
+	BuildVariables              *BuildVariablesService
+	BroadcastMessage            *BroadcastMessagesService
+	CIYMLTemplate               *CIYMLTemplatesService
+	Commits                     *CommitsService
+	CustomAttribute             *CustomAttributesService
+	DeployKeys                  *DeployKeysService
+	Deployments                 *DeploymentsService
+	Discussions                 *DiscussionsService
+	DockerfileTemplates         *DockerfileTemplatesService
+	Environments                *EnvironmentsService
+	Epics                       *EpicsService
+	Events                      *EventsService
+	Features                    *FeaturesService
+	FreezePeriods               *FreezePeriodsService
+	GitIgnoreTemplates          *GitIgnoreTemplatesService
+	GraphQL                     *GraphQLService
+	GroupActivityAnalytics      *GroupActivityAnalyticsService
+	Groups                      *GroupsService
+	GroupClusters               *GroupClustersService
+	GroupIssueBoards            *GroupIssueBoardsService
+	GroupMembers                *GroupMembersService
+	GroupMilestones             *GroupMilestonesService
+	GroupSSHCertificates        *GroupSSHCertificatesService
+	GroupVariables              *GroupVariablesService
+	Import                      *ImportService
+	Issues                      *IssuesService
+	IssueLinks                  *IssueLinksService
+	InstanceClusters            *InstanceClustersService
+	Jobs                        *JobsService
+	Keys                        *KeysService
+	Boards                      *IssueBoardsService
+	Labels                      *LabelsService
+	License                     *LicenseService
+	LicenseTemplates            *LicenseTemplatesService
+	MergeRequests               *MergeRequestsService
+	MergeRequestApprovals       *MergeRequestApprovalsService
+	Milestones                  *MilestonesService
+	Namespaces                  *NamespacesService
+	Notes                       *NotesService
+	NotificationSettings        *NotificationSettingsService
+	PagesDomains                *PagesDomainsService
+	PersonalAccessTokens        *PersonalAccessTokensService
+	Pipelines                   *PipelinesService
+	PipelineSchedules           *PipelineSchedulesService
+	PipelineTriggers            *PipelineTriggersService
+	Projects                    *ProjectsService
+	ProjectMembers              *ProjectMembersService
+	ProjectBadges               *ProjectBadgesService
+	ProjectClusters             *ProjectClustersService
+	ProjectSnippets             *ProjectSnippetsService
+	ProjectTemplates            *ProjectTemplatesService
+	ProjectVariables            *ProjectVariablesService
+	ProtectedBranches           *ProtectedBranchesService
+	ProtectedTags               *ProtectedTagsService
+	RelatedEpicLinks            *RelatedEpicLinksService
+	Releases                    *ReleasesService
+	Repositories                *RepositoriesService
+	RepositoryFiles             *RepositoryFilesService
+	RepositoryStorageMoves      *RepositoryStorageMovesService
+	RepositorySubmodules        *RepositorySubmodulesService
+	Runners                     *RunnersService
+	Search                      *SearchService
+	ServiceAccounts             *ServiceAccountsService
+	Services                    *ServicesService
+	Settings                    *SettingsService
+	Sidekiq                     *SidekiqService
+	Snippets                    *SnippetsService
+	SystemHooks                 *SystemHooksService
+	Tags                        *TagsService
+	Todos                       *TodosService
+	Topics                      *TopicsService
+	UsageData                   *UsageDataService
+	Users                       *UsersService
+	Validate                    *ValidateService
+	Version                     *VersionService
+	Vulnerabilities             *VulnerabilitiesService
+	VulnerabilityFindings       *VulnerabilityFindingsService
+	Wikis                       *WikisService
 }
 
 // ListOptions specifies the optional parameters to various List methods that
@@ -356,27 +482,55 @@ type ListOptions struct {
 
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Pagination selects the pagination method. Set it to "keyset" to use
+	// keyset (cursor) pagination on endpoints that support it, and follow
+	// Response.NextLink using WithKeysetPaginationParameters.
+	Pagination string `url:"pagination,omitempty" json:"pagination,omitempty"`
 }
 
+// ClientOptionFunc can be passed to the client constructors to customize
+// the behavior of the returned client.
+type ClientOptionFunc func(*Client)
+
 // NewClient returns a new GitLab API client. If a nil httpClient is
 // provided, http.DefaultClient will be used. To use API methods which require
 // authentication, provide a valid private or personal token.
-func NewClient(httpClient *http.Client, token string) *Client {
+func NewClient(httpClient *http.Client, token string, options ...ClientOptionFunc) *Client {
 	client := newClient(httpClient)
 	client.authType = privateToken
 	client.token = token
+	client.applyOptions(options)
 	return client
 }
 
+// NewJobClient returns a new GitLab API client that authenticates using a CI
+// job token, as found in the CI_JOB_TOKEN variable of a running job. Only
+// some endpoints accept job tokens, like those to create releases, publish
+// packages and trigger pipelines. If a nil httpClient is provided,
+// http.DefaultClient will be used. baseURL is the URL of the GitLab API,
+// usually taken from the CI_API_V4_URL variable.
+func NewJobClient(httpClient *http.Client, token, baseURL string, options ...ClientOptionFunc) (*Client, error) {
+	client := newClient(httpClient)
+	client.authType = jobToken
+	client.token = token
+	if err := client.SetBaseURL(baseURL); err != nil {
+		return nil, err
+	}
+	client.applyOptions(options)
+	return client, nil
+}
+
 // NewBasicAuthClient returns a new GitLab API client. If a nil httpClient is
 // provided, http.DefaultClient will be used. To use API methods which require
 // authentication, provide a valid username and password.
-func NewBasicAuthClient(httpClient *http.Client, endpoint, username, password string) (*Client, error) {
+func NewBasicAuthClient(httpClient *http.Client, endpoint, username, password string, options ...ClientOptionFunc) (*Client, error) {
 	client := newClient(httpClient)
 	client.authType = basicAuth
 	client.username = username
 	client.password = password
 	client.SetBaseURL(endpoint)
+	client.applyOptions(options)
 
 	err := client.requestOAuthToken(context.TODO())
 	if err != nil {
@@ -387,10 +541,13 @@ func NewBasicAuthClient(httpClient *http.Client, endpoint, username, password st
 }
 
 func (c *Client) requestOAuthToken(ctx context.Context) error {
+	// The OAuth endpoints live next to the API, so GitLab installations under
+	// a relative URL (e.g. https://example.com/gitlab/) keep working.
+	root := strings.TrimSuffix(c.BaseURL().String(), apiVersionPath)
 	config := &oauth2.Config{
 		Endpoint: oauth2.Endpoint{
-			AuthURL:  fmt.Sprintf("%s://%s/oauth/authorize", c.BaseURL().Scheme, c.BaseURL().Host),
-			TokenURL: fmt.Sprintf("%s://%s/oauth/token", c.BaseURL().Scheme, c.BaseURL().Host),
+			AuthURL:  root + "oauth/authorize",
+			TokenURL: root + "oauth/token",
 		},
 	}
 	ctx = context.WithValue(ctx, oauth2.HTTPClient, c.client)
@@ -405,10 +562,25 @@ func (c *Client) requestOAuthToken(ctx context.Context) error {
 // NewOAuthClient returns a new GitLab API client. If a nil httpClient is
 // provided, http.DefaultClient will be used. To use API methods which require
 // authentication, provide a valid oauth token.
-func NewOAuthClient(httpClient *http.Client, token string) *Client {
+func NewOAuthClient(httpClient *http.Client, token string, options ...ClientOptionFunc) *Client {
 	client := newClient(httpClient)
 	client.authType = oAuthToken
 	client.token = token
+	client.applyOptions(options)
+	return client
+}
+
+// NewOAuthTokenSourceClient returns a new GitLab API client that gets its
+// OAuth tokens from ts, for example the token source of an oauth2.Config of
+// a GitLab OAuth application. Tokens are reused until they expire, after
+// which ts is asked for a new one, so refreshable tokens are refreshed
+// transparently. If a nil httpClient is provided, http.DefaultClient will be
+// used.
+func NewOAuthTokenSourceClient(httpClient *http.Client, ts oauth2.TokenSource, options ...ClientOptionFunc) *Client {
+	client := newClient(httpClient)
+	client.authType = oAuthToken
+	client.tokenSource = oauth2.ReuseTokenSource(nil, ts)
+	client.applyOptions(options)
 	return client
 }
 
@@ -423,12 +595,21 @@ func newClient(httpClient *http.Client) *Client {
 		panic(err)
 	}
 
+	c.initServices()
+
+	return c
+}
+
+// initServices creates all services, bound to the client.
+func (c *Client) initServices() {
 	// Create the internal timeStats service.
 	timeStats := &timeStatsService{client: c}
 
 	// Create all the public services.
 	c.AccessRequests = &AccessRequestsService{client: c}
+	c.AuditEventStreaming = &AuditEventStreamingService{client: c}
 	c.AwardEmoji = &AwardEmojiService{client: c}
+	c.BatchedBackgroundMigrations = &BatchedBackgroundMigrationsService{client: c}
 	c.Branches = &BranchesService{client: c}
 	c.BuildVariables = &BuildVariablesService{client: c}
 	c.BroadcastMessage = &BroadcastMessagesService{client: c}
@@ -438,17 +619,26 @@ func newClient(httpClient *http.Client) *Client {
 	c.DeployKeys = &DeployKeysService{client: c}
 	c.Deployments = &DeploymentsService{client: c}
 	c.Discussions = &DiscussionsService{client: c}
+	c.DockerfileTemplates = &DockerfileTemplatesService{client: c}
 	c.Environments = &EnvironmentsService{client: c}
+	c.Epics = &EpicsService{client: c}
 	c.Events = &EventsService{client: c}
 	c.Features = &FeaturesService{client: c}
+	c.FreezePeriods = &FreezePeriodsService{client: c}
 	c.GitIgnoreTemplates = &GitIgnoreTemplatesService{client: c}
+	c.GraphQL = &GraphQLService{client: c}
+	c.GroupActivityAnalytics = &GroupActivityAnalyticsService{client: c}
 	c.Groups = &GroupsService{client: c}
+	c.GroupClusters = &GroupClustersService{client: c}
 	c.GroupIssueBoards = &GroupIssueBoardsService{client: c}
 	c.GroupMembers = &GroupMembersService{client: c}
 	c.GroupMilestones = &GroupMilestonesService{client: c}
+	c.GroupSSHCertificates = &GroupSSHCertificatesService{client: c}
 	c.GroupVariables = &GroupVariablesService{client: c}
 	c.Issues = &IssuesService{client: c, timeStats: timeStats}
+	c.Import = &ImportService{client: c}
 	c.IssueLinks = &IssueLinksService{client: c}
+	c.InstanceClusters = &InstanceClustersService{client: c}
 	c.Jobs = &JobsService{client: c}
 	c.Keys = &KeysService{client: c}
 	c.Boards = &IssueBoardsService{client: c}
@@ -462,19 +652,27 @@ func newClient(httpClient *http.Client) *Client {
 	c.Notes = &NotesService{client: c}
 	c.NotificationSettings = &NotificationSettingsService{client: c}
 	c.PagesDomains = &PagesDomainsService{client: c}
+	c.PersonalAccessTokens = &PersonalAccessTokensService{client: c}
 	c.Pipelines = &PipelinesService{client: c}
 	c.PipelineSchedules = &PipelineSchedulesService{client: c}
 	c.PipelineTriggers = &PipelineTriggersService{client: c}
 	c.Projects = &ProjectsService{client: c}
 	c.ProjectMembers = &ProjectMembersService{client: c}
 	c.ProjectBadges = &ProjectBadgesService{client: c}
+	c.ProjectClusters = &ProjectClustersService{client: c}
 	c.ProjectSnippets = &ProjectSnippetsService{client: c}
+	c.ProjectTemplates = &ProjectTemplatesService{client: c}
 	c.ProjectVariables = &ProjectVariablesService{client: c}
 	c.ProtectedBranches = &ProtectedBranchesService{client: c}
 	c.ProtectedTags = &ProtectedTagsService{client: c}
+	c.RelatedEpicLinks = &RelatedEpicLinksService{client: c}
+	c.Releases = &ReleasesService{client: c}
 	c.Repositories = &RepositoriesService{client: c}
 	c.RepositoryFiles = &RepositoryFilesService{client: c}
+	c.RepositoryStorageMoves = &RepositoryStorageMovesService{client: c}
+	c.RepositorySubmodules = &RepositorySubmodulesService{client: c}
 	c.Runners = &RunnersService{client: c}
+	c.ServiceAccounts = &ServiceAccountsService{client: c}
 	c.Services = &ServicesService{client: c}
 	c.Search = &SearchService{client: c}
 	c.Settings = &SettingsService{client: c}
@@ -483,12 +681,85 @@ func newClient(httpClient *http.Client) *Client {
 	c.SystemHooks = &SystemHooksService{client: c}
 	c.Tags = &TagsService{client: c}
 	c.Todos = &TodosService{client: c}
+	c.Topics = &TopicsService{client: c}
+	c.UsageData = &UsageDataService{client: c}
 	c.Users = &UsersService{client: c}
 	c.Validate = &ValidateService{client: c}
 	c.Version = &VersionService{client: c}
+	c.Vulnerabilities = &VulnerabilitiesService{client: c}
+	c.VulnerabilityFindings = &VulnerabilityFindingsService{client: c}
 	c.Wikis = &WikisService{client: c}
+}
 
-	return c
+// Clone returns a copy of the client that shares the same HTTP client, base
+// URL and options, but can be configured independently afterwards.
+func (c *Client) Clone() *Client {
+	clone := *c
+	clone.baseURL = c.BaseURL()
+	clone.initServices()
+	return &clone
+}
+
+// WithAuth returns a copy of the client that authenticates using the given
+// token instead, while sharing the same HTTP client, base URL and options.
+// This is a cheap way to make requests on behalf of multiple identities, for
+// example when using per-tenant tokens. Clients created with
+// NewBasicAuthClient are turned into clients using the given OAuth token.
+func (c *Client) WithAuth(token string) *Client {
+	clone := c.Clone()
+	if clone.authType == basicAuth {
+		clone.authType = oAuthToken
+		clone.username, clone.password = "", ""
+	}
+	clone.token = token
+	clone.tokens = nil
+	clone.tokenSource = nil
+	return clone
+}
+
+// applyOptions applies the given client options in order.
+func (c *Client) applyOptions(options []ClientOptionFunc) {
+	for _, fn := range options {
+		if fn != nil {
+			fn(c)
+		}
+	}
+}
+
+// WithRateLimitRetry makes the client transparently retry requests that are
+// rejected with a 429 Too Many Requests response. The client waits until the
+// time indicated by the Retry-After or RateLimit-Reset response header, but
+// never longer than maxWait, and gives up after the given number of retries.
+func WithRateLimitRetry(retries int, maxWait time.Duration) ClientOptionFunc {
+	return func(c *Client) {
+		c.rateLimitRetries = retries
+		c.rateLimitMaxWait = maxWait
+	}
+}
+
+// WithDefaultSudo makes the client impersonate the given user, identified
+// by username or user ID, on all requests. This requires an administrator
+// token. A WithSudo request option overrides the default for a single
+// request.
+//
+// GitLab docs: https://docs.gitlab.com/ce/api/README.html#sudo
+func WithDefaultSudo(uid interface{}) ClientOptionFunc {
+	return func(c *Client) {
+		c.sudo = uid
+	}
+}
+
+// WithDeprecationHandler sets a function that is called for every response
+// that has a Deprecation or Sunset header, so consumers learn when they are
+// using endpoints that are scheduled for removal. For example:
+//
+//	gitlab.WithDeprecationHandler(func(resp *gitlab.Response) {
+//		log.Printf("%s %s is deprecated", resp.Request.Method, resp.Request.URL.Path)
+//	})
+func WithDeprecationHandler(fn func(*Response)) ClientOptionFunc {
+	return func(c *Client) {
+		c.deprecationHandler = fn
+	}
 }
 
 // BaseURL return a copy of the baseURL.
@@ -512,6 +783,9 @@ func (c *Client) SetBaseURL(urlStr string) error {
 
 	if !strings.HasSuffix(baseURL.Path, apiVersionPath) {
 		baseURL.Path += apiVersionPath
+		if baseURL.RawPath != "" {
+			baseURL.RawPath += apiVersionPath
+		}
 	}
 
 	// Update the base URL of the client.
@@ -523,27 +797,25 @@ func (c *Client) SetBaseURL(urlStr string) error {
 // NewRequest creates an API request. A relative URL path can be provided in
 // urlStr, in which case it is resolved relative to the base URL of the Client.
 // Relative URL paths should always be specified without a preceding slash. If
-// specified, the value pointed to by body is JSON encoded and included as the
-// request body.
+// specified, the value pointed to by opt is JSON encoded and included as the
+// request body of POST, PUT and PATCH requests, using its json struct tags,
+// and encoded as the query string of all other requests, using its url struct
+// tags.
 func (c *Client) NewRequest(method, path string, opt interface{}, options []OptionFunc) (*http.Request, error) {
 	u := *c.baseURL
+
+	// Paths are always relative to the base URL, which may contain a path
+	// prefix when GitLab is installed under a relative URL.
+	path = strings.TrimPrefix(path, "/")
 	unescaped, err := url.PathUnescape(path)
 	if err != nil {
 		return nil, err
 	}
 
 	// Set the encoded path data
-	u.RawPath = c.baseURL.Path + path
+	u.RawPath = c.baseURL.EscapedPath() + path
 	u.Path = c.baseURL.Path + unescaped
 
-	if opt != nil {
-		q, err := query.Values(opt)
-		if err != nil {
-			return nil, err
-		}
-		u.RawQuery = q.Encode()
-	}
-
 	req := &http.Request{
 		Method:     method,
 		URL:        &u,
@@ -554,6 +826,38 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Opti
 		Host:       u.Host,
 	}
 
+	if !isNilOption(opt) {
+		switch method {
+		case "POST", "PUT", "PATCH":
+			bodyBytes, err := json.Marshal(opt)
+			if err != nil {
+				return nil, err
+			}
+			bodyReader := bytes.NewReader(bodyBytes)
+
+			req.Body = ioutil.NopCloser(bodyReader)
+			req.GetBody = func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(bytes.NewReader(bodyBytes)), nil
+			}
+			req.ContentLength = int64(bodyReader.Len())
+			req.Header.Set("Content-Type", "application/json")
+		default:
+			q, err := query.Values(opt)
+			if err != nil {
+				return nil, err
+			}
+			u.RawQuery = q.Encode()
+		}
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if c.sudo != nil {
+		if err := WithSudo(c.sudo)(req); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, fn := range options {
 		if fn == nil {
 			continue
@@ -564,36 +868,134 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Opti
 		}
 	}
 
-	if method == "POST" || method == "PUT" {
-		bodyBytes, err := json.Marshal(opt)
+	token := c.token
+	switch {
+	case c.tokens != nil:
+		token = c.tokens.get()
+	case c.tokenSource != nil:
+		t, err := c.tokenSource.Token()
 		if err != nil {
 			return nil, err
 		}
-		bodyReader := bytes.NewReader(bodyBytes)
+		token = t.AccessToken
+	}
+	c.setAuthHeader(req, token)
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	return req, nil
+}
 
-		u.RawQuery = ""
-		req.Body = ioutil.NopCloser(bodyReader)
-		req.GetBody = func() (io.ReadCloser, error) {
-			return ioutil.NopCloser(bodyReader), nil
+// isNilOption reports whether opt is nil or a nil pointer, in which case no
+// options are sent at all.
+func isNilOption(opt interface{}) bool {
+	if opt == nil {
+		return true
+	}
+	v := reflect.ValueOf(opt)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// UploadRequest creates a multipart API request that uploads the content
+// read from content as a file with the given filename, using the given form
+// field. If specified, the values of opt are sent as additional form fields.
+//
+// The content is read into memory when the request is created, so the request
+// can be sent again when it is retried.
+func (c *Client) UploadRequest(method, path string, content io.Reader, filename, field string, opt interface{}, options []OptionFunc) (*http.Request, error) {
+	b := new(bytes.Buffer)
+	w := multipart.NewWriter(b)
+
+	if opt != nil {
+		fields, err := query.Values(opt)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			for _, v := range fields[name] {
+				if err := w.WriteField(name, v); err != nil {
+					return nil, err
+				}
+			}
 		}
-		req.ContentLength = int64(bodyReader.Len())
-		req.Header.Set("Content-Type", "application/json")
 	}
 
-	req.Header.Set("Accept", "application/json")
+	fw, err := w.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(fw, content); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(method, path, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyBytes := b.Bytes()
+	req.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(bodyBytes)), nil
+	}
+	req.ContentLength = int64(len(bodyBytes))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	return req, nil
+}
 
+// removeAvatarRequest creates a request that removes the avatar of the
+// project or group at path. GitLab only removes avatars when the avatar is
+// sent as an empty form field.
+func (c *Client) removeAvatarRequest(path string, options []OptionFunc) (*http.Request, error) {
+	req, err := c.NewRequest("PUT", path, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	body := []byte(url.Values{"avatar": {""}}.Encode())
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
+// setAuthHeader sets the header used to authenticate the request.
+func (c *Client) setAuthHeader(req *http.Request, token string) {
 	switch c.authType {
 	case basicAuth, oAuthToken:
-		req.Header.Set("Authorization", "Bearer "+c.token)
+		req.Header.Set("Authorization", "Bearer "+token)
 	case privateToken:
-		req.Header.Set("PRIVATE-TOKEN", c.token)
+		req.Header.Set("PRIVATE-TOKEN", token)
+	case jobToken:
+		req.Header.Set("JOB-TOKEN", token)
 	}
+}
 
-	if c.UserAgent != "" {
-		req.Header.Set("User-Agent", c.UserAgent)
+// authToken returns the token used to authenticate the request.
+func (c *Client) authToken(req *http.Request) string {
+	switch c.authType {
+	case privateToken:
+		return req.Header.Get("PRIVATE-TOKEN")
+	case jobToken:
+		return req.Header.Get("JOB-TOKEN")
 	}
-
-	return req, nil
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
 }
 
 // Response is a GitLab API response. This wraps the standard http.Response
@@ -612,15 +1014,82 @@ type Response struct {
 	CurrentPage  int
 	NextPage     int
 	PreviousPage int
+
+	// NextLink is the URL of the next page taken from the Link header. When
+	// using keyset pagination it carries the cursor of the next page, and is
+	// empty on the last page.
+	NextLink string
+
+	// These fields provide the rate limit values from the RateLimit response
+	// headers, so callers can slow down before being rate limited. They are
+	// zero when GitLab does not send the headers, for example when rate
+	// limiting is disabled.
+	RateLimitLimit     int
+	RateLimitObserved  int
+	RateLimitRemaining int
+	RateLimitReset     *time.Time
+
+	// Deprecated is set if the Deprecation header indicates that the endpoint
+	// is deprecated. DeprecatedAt and SunsetAt are set when the Deprecation
+	// and Sunset headers contain the date the endpoint was (or will be)
+	// deprecated, and the date it will be removed.
+	Deprecated   bool
+	DeprecatedAt *time.Time
+	SunsetAt     *time.Time
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
+	response.populateRateLimitValues()
+	response.populateDeprecationValues()
 	return response
 }
 
+// populateDeprecationValues parses the Deprecation and Sunset response
+// headers. The Deprecation header is either a boolean, an HTTP date or a
+// structured field date (e.g. "@1688169599").
+func (r *Response) populateDeprecationValues() {
+	if v := r.Response.Header.Get("Deprecation"); v != "" && v != "false" {
+		r.Deprecated = true
+
+		if strings.HasPrefix(v, "@") {
+			if sec, err := strconv.ParseInt(v[1:], 10, 64); err == nil {
+				t := time.Unix(sec, 0).UTC()
+				r.DeprecatedAt = &t
+			}
+		} else if t, err := http.ParseTime(v); err == nil {
+			r.DeprecatedAt = &t
+		}
+	}
+	if v := r.Response.Header.Get("Sunset"); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			r.SunsetAt = &t
+		}
+	}
+}
+
+// populateRateLimitValues parses the RateLimit response headers. The reset
+// time is sent as a Unix timestamp.
+func (r *Response) populateRateLimitValues() {
+	if limit := r.Response.Header.Get("RateLimit-Limit"); limit != "" {
+		r.RateLimitLimit, _ = strconv.Atoi(limit)
+	}
+	if observed := r.Response.Header.Get("RateLimit-Observed"); observed != "" {
+		r.RateLimitObserved, _ = strconv.Atoi(observed)
+	}
+	if remaining := r.Response.Header.Get("RateLimit-Remaining"); remaining != "" {
+		r.RateLimitRemaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Response.Header.Get("RateLimit-Reset"); reset != "" {
+		if sec, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			t := time.Unix(sec, 0).UTC()
+			r.RateLimitReset = &t
+		}
+	}
+}
+
 const (
 	xTotal      = "X-Total"
 	xTotalPages = "X-Total-Pages"
@@ -651,6 +1120,32 @@ func (r *Response) populatePageValues() {
 	if previousPage := r.Response.Header.Get(xPrevPage); previousPage != "" {
 		r.PreviousPage, _ = strconv.Atoi(previousPage)
 	}
+
+	// The X-Next-Page header is not set by all endpoints, but the next page
+	// is then still available from the Link header.
+	r.NextLink = linkURL(r.Response.Header.Get("Link"), "next")
+	if r.NextPage == 0 && r.NextLink != "" {
+		if u, err := url.Parse(r.NextLink); err == nil {
+			r.NextPage, _ = strconv.Atoi(u.Query().Get("page"))
+		}
+	}
+}
+
+// linkURL returns the URL of the link with the given relation in the value
+// of a Link header, or an empty string if there is no such link.
+func linkURL(header, rel string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="`+rel+`"` {
+				return strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			}
+		}
+	}
+	return ""
 }
 
 // Do sends an API request and returns the API response. The API response is
@@ -659,10 +1154,61 @@ func (r *Response) populatePageValues() {
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	if cancel, ok := req.Context().Value(timeoutCancelKey{}).(context.CancelFunc); ok {
+		defer cancel()
+	}
+
+	if w, ok := c.dryRunWriter(req); ok {
+		return dryRun(w, req)
+	}
+
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
+
+	for retry, rotations := 0, 0; resp.StatusCode == http.StatusTooManyRequests; {
+		wait, ok := rateLimitWait(resp.Header)
+
+		if c.tokens != nil && rotations < len(c.tokens.tokens)-1 {
+			d := wait
+			if !ok {
+				d = defaultThrottleDuration
+			}
+			if token, ok := c.tokens.throttle(c.authToken(req), d); ok {
+				resp.Body.Close()
+				if err := rewindBody(req); err != nil {
+					return nil, err
+				}
+				c.setAuthHeader(req, token)
+				rotations++
+
+				resp, err = c.send(req)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+
+		if retry >= c.rateLimitRetries || !ok || wait > c.rateLimitMaxWait {
+			break
+		}
+		retry++
+		resp.Body.Close()
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		if err := rewindBody(req); err != nil {
+			return nil, err
+		}
+
+		resp, err = c.send(req)
+		if err != nil {
+			return nil, err
+		}
+	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized && c.authType == basicAuth {
@@ -670,10 +1216,16 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err := rewindBody(req); err != nil {
+			return nil, err
+		}
 		return c.Do(req, v)
 	}
 
 	response := newResponse(resp)
+	if c.deprecationHandler != nil && (response.Deprecated || response.SunsetAt != nil) {
+		c.deprecationHandler(response)
+	}
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -693,27 +1245,125 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	return response, err
 }
 
+// rateLimitWait returns how long to wait before retrying a rate limited
+// request, based on the Retry-After or RateLimit-Reset response headers.
+func rateLimitWait(h http.Header) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return nonNegative(time.Duration(seconds) * time.Second), true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(time.Until(t)), true
+		}
+	}
+	if v := h.Get("RateLimit-Reset"); v != "" {
+		if reset, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return nonNegative(time.Until(time.Unix(reset, 0))), true
+		}
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// sleepContext waits for the given duration or until ctx is done, whichever
+// happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rewindBody resets the request body so the request can be send again.
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// ProjectIdentifier is implemented by types that identify a project or a
+// group, so values of these types can be passed as project or group ID to
+// all API calls. GitLabID returns the numeric ID or the namespaced path.
+type ProjectIdentifier interface {
+	GitLabID() string
+}
+
 // Helper function to accept and format both the project ID or name as project
 // identifier for all API calls.
 func parseID(id interface{}) (string, error) {
 	switch v := id.(type) {
 	case int:
 		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
 	case string:
 		return v, nil
-	default:
-		return "", fmt.Errorf("invalid ID type %#v, the ID must be an int or a string", id)
+	case *Project:
+		if v != nil {
+			return strconv.Itoa(v.ID), nil
+		}
+	case *Group:
+		if v != nil {
+			return strconv.Itoa(v.ID), nil
+		}
+	case ProjectIdentifier:
+		return v.GitLabID(), nil
 	}
+	return "", fmt.Errorf("invalid ID type %#v, the ID must be an int, an int64, a string, a *Project, a *Group or a ProjectIdentifier", id)
 }
 
+// pathEscape escapes a single path segment, like the namespaced path of a
+// project ("group/subgroup/project"). Dots are escaped as well, as GitLab
+// would otherwise interpret everything after the last dot as the format of
+// the response.
+func pathEscape(s string) string {
+	return strings.Replace(url.PathEscape(s), ".", "%2E", -1)
+}
+
+// Sentinel errors matching API errors by their response status code, to be
+// used with errors.Is. For example:
+//
+//	if errors.Is(err, gitlab.ErrNotFound) {
+//		...
+//	}
+var (
+	ErrBadRequest      = errors.New("bad request")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrServerError     = errors.New("server error")
+)
+
 // An ErrorResponse reports one or more errors caused by an API request.
+// Use errors.As to get it from an error returned by an API call, or
+// errors.Is with one of the sentinel errors to check for a class of errors.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/README.html#data-validation-and-error-reporting
 type ErrorResponse struct {
-	Body     []byte
-	Response *http.Response
-	Message  string
+	Body       []byte
+	Response   *http.Response
+	StatusCode int
+	Message    string
 }
 
 func (e *ErrorResponse) Error() string {
@@ -722,14 +1372,76 @@ func (e *ErrorResponse) Error() string {
 	return fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, u, e.Response.StatusCode, e.Message)
 }
 
+// Is reports whether the error matches target, which is one of the sentinel
+// errors like ErrNotFound. ErrServerError matches all 5xx responses.
+func (e *ErrorResponse) Is(target error) bool {
+	code := e.statusCode()
+	switch target {
+	case ErrBadRequest:
+		return code == http.StatusBadRequest
+	case ErrUnauthorized:
+		return code == http.StatusUnauthorized
+	case ErrForbidden:
+		return code == http.StatusForbidden
+	case ErrNotFound:
+		return code == http.StatusNotFound
+	case ErrConflict:
+		return code == http.StatusConflict
+	case ErrTooManyRequests:
+		return code == http.StatusTooManyRequests
+	case ErrServerError:
+		return code >= 500 && code < 600
+	}
+	return false
+}
+
+// statusCode returns the status code of the response, also for error
+// responses that were created without setting StatusCode.
+func (e *ErrorResponse) statusCode() int {
+	if e.StatusCode == 0 && e.Response != nil {
+		return e.Response.StatusCode
+	}
+	return e.StatusCode
+}
+
+// IsNotFound reports whether err is an API error caused by a 404 Not Found
+// response.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err is an API error caused by a 401
+// Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden reports whether err is an API error caused by a 403 Forbidden
+// response.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsConflict reports whether err is an API error caused by a 409 Conflict
+// response.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsRateLimited reports whether err is an API error caused by a 429 Too Many
+// Requests response.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrTooManyRequests)
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 func CheckResponse(r *http.Response) error {
 	switch r.StatusCode {
-	case 200, 201, 202, 204, 304:
+	case 200, 201, 202, 204, 206, 304:
 		return nil
 	}
 
-	errorResponse := &ErrorResponse{Response: r}
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode}
 	data, err := ioutil.ReadAll(r.Body)
 	if err == nil && data != nil {
 		errorResponse.Body = data
@@ -806,6 +1518,27 @@ func WithSudo(uid interface{}) OptionFunc {
 	}
 }
 
+// WithHeader sets a header on the request, for example to pass headers that
+// are not supported by this package yet. Authentication headers are set by
+// the client and cannot be overridden.
+func WithHeader(key, value string) OptionFunc {
+	return func(req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request, for example to pass
+// parameters that are not part of the options of an API method yet.
+func WithQueryParam(key, value string) OptionFunc {
+	return func(req *http.Request) error {
+		q := req.URL.Query()
+		q.Add(key, value)
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
 // WithContext runs the request with the provided context
 func WithContext(ctx context.Context) OptionFunc {
 	return func(req *http.Request) error {
@@ -814,6 +1547,37 @@ func WithContext(ctx context.Context) OptionFunc {
 	}
 }
 
+// WithTimeout applies a deadline of the given duration to a single request,
+// independent of any timeout configured on the underlying http.Client. When
+// used together with WithContext, WithContext must be passed first as it
+// replaces the request context.
+func WithTimeout(timeout time.Duration) OptionFunc {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		ctx = context.WithValue(ctx, timeoutCancelKey{}, cancel)
+		*req = *req.WithContext(ctx)
+		return nil
+	}
+}
+
+// WithKeysetPaginationParameters replaces the query parameters of the request
+// with those of nextLink, which is the Response.NextLink of the previous page
+// of a keyset paginated list.
+func WithKeysetPaginationParameters(nextLink string) OptionFunc {
+	return func(req *http.Request) error {
+		u, err := url.Parse(nextLink)
+		if err != nil {
+			return err
+		}
+		req.URL.RawQuery = u.RawQuery
+		return nil
+	}
+}
+
+// timeoutCancelKey is the context key used to store the cancel function of a
+// per request timeout, so it can be released once the request is done.
+type timeoutCancelKey struct{}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool {
@@ -847,6 +1611,14 @@ func AccessLevel(v AccessLevelValue) *AccessLevelValue {
 	return p
 }
 
+// BranchProtection is a helper routine that allocates a new
+// BranchProtectionValue to store v and returns a pointer to it.
+func BranchProtection(v BranchProtectionValue) *BranchProtectionValue {
+	p := new(BranchProtectionValue)
+	*p = v
+	return p
+}
+
 // BuildState is a helper routine that allocates a new BuildStateValue
 // to store v and returns a pointer to it.
 func BuildState(v BuildStateValue) *BuildStateValue {
@@ -879,6 +1651,54 @@ func MergeMethod(v MergeMethodValue) *MergeMethodValue {
 	return p
 }
 
+// AccessControl is a helper routine that allocates a new AccessControlValue
+// to store v and returns a pointer to it.
+func AccessControl(v AccessControlValue) *AccessControlValue {
+	p := new(AccessControlValue)
+	*p = v
+	return p
+}
+
+// SquashOption is a helper routine that allocates a new SquashOptionValue
+// to store v and returns a pointer to it.
+func SquashOption(v SquashOptionValue) *SquashOptionValue {
+	p := new(SquashOptionValue)
+	*p = v
+	return p
+}
+
+// DeploymentApprovalStatus is a helper routine that allocates a new
+// DeploymentApprovalStatusValue to store v and returns a pointer to it.
+func DeploymentApprovalStatus(v DeploymentApprovalStatusValue) *DeploymentApprovalStatusValue {
+	p := new(DeploymentApprovalStatusValue)
+	*p = v
+	return p
+}
+
+// ReleaseLinkType is a helper routine that allocates a new
+// ReleaseLinkTypeValue to store v and returns a pointer to it.
+func ReleaseLinkType(v ReleaseLinkTypeValue) *ReleaseLinkTypeValue {
+	p := new(ReleaseLinkTypeValue)
+	*p = v
+	return p
+}
+
+// SharedRunnersSetting is a helper routine that allocates a new
+// SharedRunnersSettingValue to store v and returns a pointer to it.
+func SharedRunnersSetting(v SharedRunnersSettingValue) *SharedRunnersSettingValue {
+	p := new(SharedRunnersSettingValue)
+	*p = v
+	return p
+}
+
+// VariableType is a helper routine that allocates a new VariableTypeValue
+// to store v and returns a pointer to it.
+func VariableType(v VariableTypeValue) *VariableTypeValue {
+	p := new(VariableTypeValue)
+	*p = v
+	return p
+}
+
 // BoolValue is a boolean value with advanced json unmarshaling features.
 type BoolValue bool
 
@@ -4,11 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
 )
 
 // setup sets up a test HTTP server along with a gitlab.Client that is
@@ -70,6 +77,37 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestClientWithAuth(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var tokens []string
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Private-Token"))
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	tenant := client.WithAuth("tenant-token")
+	if tenant.BaseURL().String() != client.BaseURL().String() {
+		t.Errorf("WithAuth BaseURL is %s, want %s", tenant.BaseURL(), client.BaseURL())
+	}
+	if tenant.Users.client != tenant {
+		t.Errorf("WithAuth services are not bound to the new client")
+	}
+
+	if _, _, err := tenant.Users.CurrentUser(); err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
+	}
+	if _, _, err := client.Users.CurrentUser(); err != nil {
+		t.Fatalf("Users.CurrentUser returned error: %v", err)
+	}
+
+	want := []string{"tenant-token", ""}
+	if !reflect.DeepEqual(want, tokens) {
+		t.Errorf("Requests used tokens %q, want %q", tokens, want)
+	}
+}
+
 func TestSetBaseURL(t *testing.T) {
 	expectedBaseURL := "http://gitlab.local/foo/" + apiVersionPath
 	c := NewClient(nil, "")
@@ -82,6 +120,218 @@ func TestSetBaseURL(t *testing.T) {
 	}
 }
 
+func TestRelativeURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		path    string
+		want    string
+	}{
+		{"https://example.com/gitlab", "projects", "https://example.com/gitlab/api/v4/projects"},
+		{"https://example.com/gitlab/", "projects/1/issues", "https://example.com/gitlab/api/v4/projects/1/issues"},
+		{"https://example.com/gitlab/api/v4", "projects/group%2Fproject", "https://example.com/gitlab/api/v4/projects/group%2Fproject"},
+		{"https://example.com/git%20lab/", "projects/group%2Fproject", "https://example.com/git%20lab/api/v4/projects/group%2Fproject"},
+		{"https://example.com/gitlab/", "/sidekiq/queue_metrics", "https://example.com/gitlab/api/v4/sidekiq/queue_metrics"},
+	}
+
+	for _, tt := range tests {
+		c := NewClient(nil, "")
+		if err := c.SetBaseURL(tt.baseURL); err != nil {
+			t.Fatalf("Failed to SetBaseURL: %v", err)
+		}
+
+		req, err := c.NewRequest("GET", tt.path, nil, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		if got := req.URL.String(); got != tt.want {
+			t.Errorf("Request URL for %s and %s is %s, want %s", tt.baseURL, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestBasicAuthRelativeURL(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/gitlab/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "bearer"}`)
+	})
+
+	c, err := NewBasicAuthClient(nil, server.URL+"/gitlab", "user", "password")
+	if err != nil {
+		t.Fatalf("NewBasicAuthClient returned error: %v", err)
+	}
+	if c.token != "token" {
+		t.Errorf("NewBasicAuthClient token is %q, want %q", c.token, "token")
+	}
+}
+
+func TestNewRequestOptionEncoding(t *testing.T) {
+	c := NewClient(nil, "")
+
+	type testOptions struct {
+		Name   *string `url:"name,omitempty" json:"name,omitempty"`
+		Labels Labels  `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	}
+	opt := &testOptions{Name: String("n"), Labels: Labels{"a", "b"}}
+
+	req, err := c.NewRequest("GET", "test", opt, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if req.URL.RawQuery != "labels=a%2Cb&name=n" || req.Body != nil {
+		t.Errorf("GET request has query %q and body %v, want only a query", req.URL.RawQuery, req.Body)
+	}
+
+	req, err = c.NewRequest("PUT", "test", opt, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	if req.URL.RawQuery != "" || string(body) != `{"name":"n","labels":"a,b"}` {
+		t.Errorf("PUT request has query %q and body %s, want only a JSON body", req.URL.RawQuery, body)
+	}
+	if got := req.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("PUT request Content-Type is %q, want %q", got, "application/json")
+	}
+
+	for _, opt := range []interface{}{nil, (*testOptions)(nil)} {
+		req, err = c.NewRequest("POST", "test", opt, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if req.Body != nil || req.Header.Get("Content-Type") != "" {
+			t.Errorf("POST request without options has a body for %#v", opt)
+		}
+	}
+}
+
+func TestPathEscapedIDs(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.EscapedPath())
+	})
+
+	tests := []struct {
+		pid  interface{}
+		want string
+	}{
+		{1, "/api/v4/projects/1/labels"},
+		{"group/subgroup/project", "/api/v4/projects/group%2Fsubgroup%2Fproject/labels"},
+		{"group/my project.json", "/api/v4/projects/group%2Fmy%20project%2Ejson/labels"},
+		{&Project{ID: 2}, "/api/v4/projects/2/labels"},
+		{int64(3), "/api/v4/projects/3/labels"},
+		{testRepo{"group", "project"}, "/api/v4/projects/group%2Fproject/labels"},
+	}
+
+	for _, tt := range tests {
+		var b bytes.Buffer
+		project, err := parseID(tt.pid)
+		if err != nil {
+			t.Fatalf("parseID returned error: %v", err)
+		}
+
+		req, err := client.NewRequest("GET", fmt.Sprintf("projects/%s/labels", pathEscape(project)), nil, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		if _, err := client.Do(req, &b); err != nil {
+			t.Fatalf("Do returned error: %v", err)
+		}
+
+		if b.String() != tt.want {
+			t.Errorf("Request path for %v is %s, want %s", tt.pid, b.String(), tt.want)
+		}
+	}
+
+	if _, err := parseID((*Group)(nil)); err == nil {
+		t.Error("Expected an error for a nil *Group")
+	}
+}
+
+type testRepo struct {
+	owner, name string
+}
+
+func (r testRepo) GitLabID() string {
+	return r.owner + "/" + r.name
+}
+
+func TestUploadRequest(t *testing.T) {
+	c := NewClient(nil, "")
+
+	opt := struct {
+		Name *string  `url:"name,omitempty"`
+		Tags []string `url:"tags[],omitempty"`
+	}{
+		Name: String("app"),
+		Tags: []string{"linux", "amd64"},
+	}
+
+	req, err := c.UploadRequest("POST", "projects/1/uploads", strings.NewReader("content"), "app.bin", "file", &opt, nil)
+	if err != nil {
+		t.Fatalf("UploadRequest returned error: %v", err)
+	}
+	if err := req.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatalf("Failed to parse the multipart form: %v", err)
+	}
+
+	want := map[string][]string{"name": {"app"}, "tags[]": {"linux", "amd64"}}
+	if !reflect.DeepEqual(want, req.MultipartForm.Value) {
+		t.Errorf("UploadRequest sent fields %v, want %v", req.MultipartForm.Value, want)
+	}
+
+	files := req.MultipartForm.File["file"]
+	if len(files) != 1 || files[0].Filename != "app.bin" || files[0].Size != int64(len("content")) {
+		t.Errorf("UploadRequest sent files %+v, want app.bin", files)
+	}
+}
+
+func TestDecodeLargeIDs(t *testing.T) {
+	if strconv.IntSize < 64 {
+		t.Skip("IDs beyond the int32 range require a 64-bit platform")
+	}
+
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/9007199254740993/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"project_id": 9007199254740993, "target_id": 9007199254740995, "author_id": 4294967297}]`)
+	})
+
+	events, _, err := client.Projects.GetProjectEvents(int64(9007199254740993), nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectEvents returned error: %v", err)
+	}
+
+	want := []*ProjectEvent{{ProjectID: 9007199254740993, TargetID: 9007199254740995, AuthorID: 4294967297}}
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("Projects.GetProjectEvents returned %+v, want %+v", events, want)
+	}
+
+	mux.HandleFunc("/api/v4/projects/9007199254740993/issues/9007199254740997", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 9007199254740997, "iid": 4294967299, "project_id": 9007199254740993}`)
+	})
+
+	issue, _, err := client.Issues.GetIssue(int64(9007199254740993), 9007199254740997)
+	if err != nil {
+		t.Fatalf("Issues.GetIssue returned error: %v", err)
+	}
+
+	wantIssue := &Issue{ID: 9007199254740997, IID: 4294967299, ProjectID: 9007199254740993}
+	if !reflect.DeepEqual(wantIssue, issue) {
+		t.Errorf("Issues.GetIssue returned %+v, want %+v", issue, wantIssue)
+	}
+}
+
 func TestCheckResponse(t *testing.T) {
 	req, err := NewClient(nil, "").NewRequest("GET", "test", nil, nil)
 	if err != nil {
@@ -129,6 +379,94 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestErrorClassification(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var code int
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(code)
+		fmt.Fprint(w, `{"message": "error"}`)
+	})
+
+	checks := map[string]func(error) bool{
+		"IsNotFound":     IsNotFound,
+		"IsUnauthorized": IsUnauthorized,
+		"IsForbidden":    IsForbidden,
+		"IsConflict":     IsConflict,
+		"IsRateLimited":  IsRateLimited,
+	}
+	tests := map[int]string{
+		http.StatusNotFound:        "IsNotFound",
+		http.StatusUnauthorized:    "IsUnauthorized",
+		http.StatusForbidden:       "IsForbidden",
+		http.StatusConflict:        "IsConflict",
+		http.StatusTooManyRequests: "IsRateLimited",
+	}
+
+	for status, want := range tests {
+		code = status
+		_, _, err := client.Projects.GetProject(1)
+		if err == nil {
+			t.Fatalf("Projects.GetProject returned no error for status %d", status)
+		}
+
+		for name, check := range checks {
+			if got := check(err); got != (name == want) {
+				t.Errorf("%s returned %t for status %d", name, got, status)
+			}
+			if got := check(fmt.Errorf("wrapped: %w", err)); got != (name == want) {
+				t.Errorf("%s returned %t for wrapped status %d", name, got, status)
+			}
+		}
+	}
+
+	if IsNotFound(nil) || IsNotFound(errors.New("404 Not Found")) {
+		t.Error("IsNotFound returned true for a non API error")
+	}
+}
+
+func TestErrorSentinels(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var code int
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(code)
+		fmt.Fprint(w, `{"message": "error"}`)
+	})
+
+	tests := map[int]error{
+		http.StatusBadRequest:          ErrBadRequest,
+		http.StatusUnauthorized:        ErrUnauthorized,
+		http.StatusForbidden:           ErrForbidden,
+		http.StatusNotFound:            ErrNotFound,
+		http.StatusConflict:            ErrConflict,
+		http.StatusTooManyRequests:     ErrTooManyRequests,
+		http.StatusInternalServerError: ErrServerError,
+		http.StatusBadGateway:          ErrServerError,
+	}
+
+	for status, want := range tests {
+		code = status
+		_, _, err := client.Projects.GetProject(1)
+		if !errors.Is(fmt.Errorf("wrapped: %w", err), want) {
+			t.Errorf("Expected error for status %d to match %v, got %v", status, want, err)
+		}
+		if status != http.StatusNotFound && errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected error for status %d not to match %v", status, ErrNotFound)
+		}
+
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			t.Fatalf("Expected an *ErrorResponse for status %d, got %T", status, err)
+		}
+		if errResp.StatusCode != status {
+			t.Errorf("Expected StatusCode %d, got %d", status, errResp.StatusCode)
+		}
+	}
+}
+
 func TestRequestWithContext(t *testing.T) {
 	ctx := context.WithValue(context.Background(), interface{}("myKey"), interface{}("myValue"))
 	req, err := NewClient(nil, "").NewRequest("GET", "test", nil, []OptionFunc{WithContext(ctx)})
@@ -141,6 +479,321 @@ func TestRequestWithContext(t *testing.T) {
 	}
 }
 
+func TestRequestWithHeaderAndQueryParam(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects?page=2&simple=true")
+		if got := r.Header.Get("X-Profile-Token"); got != "secret" {
+			t.Errorf("X-Profile-Token header is %q, want %q", got, "secret")
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.example+json" {
+			t.Errorf("Accept header is %q, want %q", got, "application/vnd.example+json")
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	opt := &ListProjectsOptions{ListOptions: ListOptions{Page: 2}}
+	_, _, err := client.Projects.ListProjects(opt,
+		WithHeader("X-Profile-Token", "secret"),
+		WithHeader("Accept", "application/vnd.example+json"),
+		WithQueryParam("simple", "true"),
+	)
+	if err != nil {
+		t.Fatalf("Projects.ListProjects returned error: %v", err)
+	}
+}
+
+func TestRequestWithSudo(t *testing.T) {
+	client := NewClient(nil, "")
+
+	req, err := client.NewRequest("GET", "test", nil, []OptionFunc{WithSudo("jdoe")})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if got := req.Header.Get("SUDO"); got != "jdoe" {
+		t.Errorf("SUDO header is %q, want %q", got, "jdoe")
+	}
+
+	WithDefaultSudo(42)(client)
+
+	req, err = client.NewRequest("GET", "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if got := req.Header.Get("SUDO"); got != "42" {
+		t.Errorf("SUDO header is %q, want %q", got, "42")
+	}
+
+	req, err = client.NewRequest("GET", "test", nil, []OptionFunc{WithSudo("jdoe")})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if got := req.Header.Get("SUDO"); got != "jdoe" {
+		t.Errorf("SUDO header is %q, want the per request %q", got, "jdoe")
+	}
+
+	WithDefaultSudo(1.5)(client)
+	if _, err := client.NewRequest("GET", "test", nil, nil); err == nil {
+		t.Error("Expected an error for an invalid default sudo user")
+	}
+}
+
+func TestRequestWithTimeout(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	req, err := client.NewRequest("GET", "slow", nil, []OptionFunc{WithTimeout(10 * time.Millisecond)})
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if _, ok := req.Context().Deadline(); !ok {
+		t.Fatal("Deadline was not set on the request context")
+	}
+
+	if _, err := client.Do(req, nil); err == nil {
+		t.Fatal("Expected the request to time out")
+	}
+}
+
+func TestRateLimitRetry(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithRateLimitRetry(1, time.Second)(client)
+
+	var calls int
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"test"}`)
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	opt := struct {
+		Name string `url:"name" json:"name"`
+	}{"test"}
+
+	req, err := client.NewRequest("POST", "test", opt, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
+
+func TestRateLimitRetryMaxWait(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithRateLimitRetry(1, time.Second)(client)
+
+	var calls int
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, err := client.NewRequest("GET", "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	resp, err := client.Do(req, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected status %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestNewJobClient(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("JOB-TOKEN"); got != "job-token" {
+			t.Errorf("JOB-TOKEN header is %q, want %q", got, "job-token")
+		}
+		if r.Header.Get("PRIVATE-TOKEN") != "" || r.Header.Get("Authorization") != "" {
+			t.Error("Request has unexpected authentication headers")
+		}
+		fmt.Fprint(w, `{"tag_name": "v1.0"}`)
+	})
+
+	client, err := NewJobClient(nil, "job-token", server.URL+"/api/v4")
+	if err != nil {
+		t.Fatalf("NewJobClient returned error: %v", err)
+	}
+	if _, _, err := client.Releases.CreateRelease(1, &CreateReleaseOptions{TagName: String("v1.0")}); err != nil {
+		t.Fatalf("Releases.CreateRelease returned error: %v", err)
+	}
+
+	if _, err := NewJobClient(nil, "job-token", "://invalid"); err == nil {
+		t.Error("Expected NewJobClient to return an error for an invalid base URL")
+	}
+}
+
+// testTokenSource returns the given tokens one after the other.
+type testTokenSource struct {
+	tokens []*oauth2.Token
+	calls  int
+}
+
+func (ts *testTokenSource) Token() (*oauth2.Token, error) {
+	if ts.calls == len(ts.tokens) {
+		return nil, errors.New("no more tokens")
+	}
+	t := ts.tokens[ts.calls]
+	ts.calls++
+	return t, nil
+}
+
+func TestOAuthTokenSourceClient(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer teardown(server)
+
+	ts := &testTokenSource{tokens: []*oauth2.Token{
+		{AccessToken: "expired", Expiry: time.Now().Add(-time.Minute)},
+		{AccessToken: "fresh", Expiry: time.Now().Add(time.Hour)},
+	}}
+	client := NewOAuthTokenSourceClient(nil, ts)
+	client.SetBaseURL(server.URL)
+
+	var auth []string
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := client.Users.CurrentUser(); err != nil {
+			t.Fatalf("Users.CurrentUser returned error: %v", err)
+		}
+	}
+
+	want := []string{"Bearer expired", "Bearer fresh", "Bearer fresh"}
+	if !reflect.DeepEqual(want, auth) {
+		t.Errorf("Requests used %q, want %q", auth, want)
+	}
+	if ts.calls != 2 {
+		t.Errorf("Token source was called %d times, want 2", ts.calls)
+	}
+
+	if _, err := client.NewRequest("GET", "user", nil, nil); err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	ts.tokens[1].Expiry = time.Now().Add(-time.Minute)
+	if _, err := client.NewRequest("GET", "user", nil, nil); err == nil {
+		t.Error("Expected NewRequest to return the token source error")
+	}
+}
+
+func TestRateLimitHeaders(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Limit", "600")
+		w.Header().Set("RateLimit-Observed", "42")
+		w.Header().Set("RateLimit-Remaining", "558")
+		w.Header().Set("RateLimit-Reset", "1792108800")
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, err := client.NewRequest("GET", "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if resp.RateLimitLimit != 600 || resp.RateLimitObserved != 42 || resp.RateLimitRemaining != 558 {
+		t.Errorf("Rate limit is %d/%d/%d, want 600/42/558", resp.RateLimitLimit, resp.RateLimitObserved, resp.RateLimitRemaining)
+	}
+	if resp.RateLimitReset == nil || !resp.RateLimitReset.Equal(time.Unix(1792108800, 0)) {
+		t.Errorf("RateLimitReset is %v, want %v", resp.RateLimitReset, time.Unix(1792108800, 0))
+	}
+}
+
+func TestDeprecationHeaders(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var deprecated []*Response
+	WithDeprecationHandler(func(resp *Response) {
+		deprecated = append(deprecated, resp)
+	})(client)
+
+	mux.HandleFunc("/api/v4/old", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "@1688169599")
+		w.Header().Set("Sunset", "Sat, 01 Jun 2024 00:00:00 GMT")
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/api/v4/new", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, err := client.NewRequest("GET", "old", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if !resp.Deprecated {
+		t.Error("Expected the response to be deprecated")
+	}
+	if resp.DeprecatedAt == nil || !resp.DeprecatedAt.Equal(time.Unix(1688169599, 0)) {
+		t.Errorf("DeprecatedAt is %v, want %v", resp.DeprecatedAt, time.Unix(1688169599, 0))
+	}
+	if resp.SunsetAt == nil || !resp.SunsetAt.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SunsetAt is %v, want 2024-06-01", resp.SunsetAt)
+	}
+
+	req, err = client.NewRequest("GET", "new", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if len(deprecated) != 1 || deprecated[0] != resp {
+		t.Errorf("Deprecation handler was called for %d responses, want 1", len(deprecated))
+	}
+}
+
 func TestBoolValue(t *testing.T) {
 	testCases := map[string]struct {
 		data     []byte
@@ -0,0 +1,249 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GraphQLService handles communication with the GraphQL API of GitLab.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/graphql/
+type GraphQLService struct {
+	client *Client
+}
+
+// GraphQLQuery represents a GraphQL query and its variables.
+type GraphQLQuery struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError represents an error returned by the GraphQL API.
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path"`
+}
+
+func (e *GraphQLError) Error() string {
+	return e.Message
+}
+
+// GraphQLErrors represents the errors returned by the GraphQL API. The
+// GraphQL API responds with a 200 OK for failed queries, so these are
+// returned as the error of GraphQLService.Do instead.
+type GraphQLErrors []*GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Message
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+// GraphQLQueryComplexity represents the complexity of a GraphQL query. It is
+// only returned when the query selects the queryComplexity field, e.g.
+// "queryComplexity { score limit }".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/graphql/index.html#max-query-complexity
+type GraphQLQueryComplexity struct {
+	Score int `json:"score"`
+	Limit int `json:"limit"`
+}
+
+// GraphQLResponse represents a response of the GraphQL API.
+type GraphQLResponse struct {
+	*Response
+
+	// Complexity is set when the query selects the queryComplexity field.
+	Complexity *GraphQLQueryComplexity
+}
+
+// GraphQLPageInfo represents the pageInfo field of a GraphQL connection.
+type GraphQLPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// Do sends a GraphQL query and decodes the data of the response into v. If
+// the response contains errors, the data is still decoded and the errors are
+// returned as GraphQLErrors.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/graphql/
+func (s *GraphQLService) Do(q *GraphQLQuery, v interface{}, options ...OptionFunc) (*GraphQLResponse, error) {
+	req, err := s.client.NewRequest("POST", "graphql", q, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The GraphQL endpoint lives next to the REST API instead of below it.
+	req.URL.Path = strings.TrimSuffix(req.URL.Path, apiVersionPath+"graphql") + "api/graphql"
+	if req.URL.RawPath != "" {
+		req.URL.RawPath = strings.TrimSuffix(req.URL.RawPath, apiVersionPath+"graphql") + "api/graphql"
+	}
+
+	// Only mutations change anything, so queries are still sent in dry-run
+	// mode.
+	if !strings.HasPrefix(strings.TrimSpace(q.Query), "mutation") {
+		markReadOnly(req)
+	}
+
+	var body struct {
+		Data   json.RawMessage `json:"data"`
+		Errors GraphQLErrors   `json:"errors"`
+	}
+	resp, err := s.client.Do(req, &body)
+	gr := &GraphQLResponse{Response: resp}
+	if err != nil {
+		return gr, err
+	}
+
+	if len(body.Data) > 0 && !bytes.Equal(body.Data, []byte("null")) {
+		var c struct {
+			QueryComplexity *GraphQLQueryComplexity `json:"queryComplexity"`
+		}
+		if err := json.Unmarshal(body.Data, &c); err == nil {
+			gr.Complexity = c.QueryComplexity
+		}
+
+		if v != nil {
+			if err := json.Unmarshal(body.Data, v); err != nil {
+				return gr, err
+			}
+		}
+	}
+
+	if len(body.Errors) > 0 {
+		return gr, body.Errors
+	}
+
+	return gr, nil
+}
+
+// Paginate runs a query for every page of a connection, following the
+// pageInfo.endCursor of the connection until there are no more pages. The
+// query must declare an $after variable, pass it as the after argument of
+// the connection, and select pageInfo { endCursor hasNextPage }.
+//
+// The connection is located in the data of the response by path, the dot
+// separated field names leading to it (e.g. "project.issues"). The fn
+// function is called with the raw JSON of the connection of every page, and
+// the response of the last page is returned.
+//
+// Example usage:
+//
+//	q := &gitlab.GraphQLQuery{
+//		Query: `query($path: ID!, $after: String) {
+//			project(fullPath: $path) {
+//				issues(after: $after) {
+//					nodes { iid title }
+//					pageInfo { endCursor hasNextPage }
+//				}
+//			}
+//		}`,
+//		Variables: map[string]interface{}{"path": "group/project"},
+//	}
+//	_, err := git.GraphQL.Paginate(q, "project.issues", func(page json.RawMessage) error {
+//		...
+//	})
+func (s *GraphQLService) Paginate(q *GraphQLQuery, path string, fn func(json.RawMessage) error, options ...OptionFunc) (*GraphQLResponse, error) {
+	vars := make(map[string]interface{}, len(q.Variables)+1)
+	for k, v := range q.Variables {
+		vars[k] = v
+	}
+	page := &GraphQLQuery{Query: q.Query, Variables: vars}
+
+	for {
+		var data json.RawMessage
+		resp, err := s.Do(page, &data, options...)
+		if err != nil {
+			return resp, err
+		}
+
+		conn, err := graphQLField(data, path)
+		if err != nil {
+			return resp, err
+		}
+
+		var pi struct {
+			PageInfo *GraphQLPageInfo `json:"pageInfo"`
+		}
+		if err := json.Unmarshal(conn, &pi); err != nil {
+			return resp, err
+		}
+		if pi.PageInfo == nil {
+			return resp, fmt.Errorf("graphql: connection %q has no pageInfo", path)
+		}
+
+		if err := fn(conn); err != nil {
+			return resp, err
+		}
+
+		if !pi.PageInfo.HasNextPage || pi.PageInfo.EndCursor == "" {
+			return resp, nil
+		}
+		vars["after"] = pi.PageInfo.EndCursor
+	}
+}
+
+// mutate runs a GraphQL mutation and decodes the payload of the given
+// mutation field into v. The errors of the payload are returned as
+// GraphQLErrors.
+func (s *GraphQLService) mutate(q *GraphQLQuery, field string, v interface{}, options []OptionFunc) (*Response, error) {
+	var data json.RawMessage
+	gr, err := s.Do(q, &data, options...)
+
+	var resp *Response
+	if gr != nil {
+		resp = gr.Response
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	payload, err := graphQLField(data, field)
+	if err != nil {
+		return resp, err
+	}
+
+	var p struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return resp, err
+	}
+	if len(p.Errors) > 0 {
+		errs := make(GraphQLErrors, len(p.Errors))
+		for i, msg := range p.Errors {
+			errs[i] = &GraphQLError{Message: msg}
+		}
+		return resp, errs
+	}
+
+	if v != nil {
+		if err := json.Unmarshal(payload, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// graphQLField returns the raw JSON of the field at the dot separated path.
+func graphQLField(data json.RawMessage, path string) (json.RawMessage, error) {
+	for _, name := range strings.Split(path, ".") {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+
+		var ok bool
+		if data, ok = fields[name]; !ok || bytes.Equal(data, []byte("null")) {
+			return nil, fmt.Errorf("graphql: field %q of %q not found", name, path)
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,116 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGraphQLDo(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"{ currentUser { username } queryComplexity { score limit } }"}`)
+		w.Header().Set("RateLimit-Limit", "600")
+		w.Header().Set("RateLimit-Remaining", "599")
+		fmt.Fprint(w, `{"data": {"currentUser": {"username": "root"}, "queryComplexity": {"score": 2, "limit": 250}}}`)
+	})
+
+	var data struct {
+		CurrentUser struct {
+			Username string `json:"username"`
+		} `json:"currentUser"`
+	}
+	resp, err := client.GraphQL.Do(&GraphQLQuery{Query: "{ currentUser { username } queryComplexity { score limit } }"}, &data)
+	if err != nil {
+		t.Fatalf("GraphQL.Do returned error: %v", err)
+	}
+
+	if data.CurrentUser.Username != "root" {
+		t.Errorf("GraphQL.Do returned username %q, want %q", data.CurrentUser.Username, "root")
+	}
+	want := &GraphQLQueryComplexity{Score: 2, Limit: 250}
+	if !reflect.DeepEqual(want, resp.Complexity) {
+		t.Errorf("GraphQL.Do returned complexity %+v, want %+v", resp.Complexity, want)
+	}
+	if resp.RateLimitLimit != 600 || resp.RateLimitRemaining != 599 {
+		t.Errorf("GraphQL.Do returned rate limit %d/%d, want 599/600", resp.RateLimitRemaining, resp.RateLimitLimit)
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "Query has complexity of 300, which exceeds max complexity of 250"}]}`)
+	})
+
+	_, err := client.GraphQL.Do(&GraphQLQuery{Query: "{ projects { nodes { id } } }"}, nil)
+	errs, ok := err.(GraphQLErrors)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("GraphQL.Do returned error %v, want GraphQLErrors", err)
+	}
+}
+
+func TestGraphQLPaginate(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		var q GraphQLQuery
+		if err := json.Unmarshal(b, &q); err != nil {
+			t.Fatal(err)
+		}
+		if q.Variables["path"] != "group/project" {
+			t.Errorf("Request variables %v, want path", q.Variables)
+		}
+
+		switch q.Variables["after"] {
+		case nil:
+			fmt.Fprint(w, `{"data": {"project": {"issues": {"nodes": [{"iid": "1"}], "pageInfo": {"endCursor": "c1", "hasNextPage": true}}}}}`)
+		case "c1":
+			fmt.Fprint(w, `{"data": {"project": {"issues": {"nodes": [{"iid": "2"}], "pageInfo": {"endCursor": "c2", "hasNextPage": false}}}}}`)
+		default:
+			t.Errorf("Unexpected cursor %v", q.Variables["after"])
+		}
+	})
+
+	q := &GraphQLQuery{
+		Query:     "query($path: ID!, $after: String) { ... }",
+		Variables: map[string]interface{}{"path": "group/project"},
+	}
+
+	var iids []string
+	_, err := client.GraphQL.Paginate(q, "project.issues", func(page json.RawMessage) error {
+		var conn struct {
+			Nodes []struct {
+				IID string `json:"iid"`
+			} `json:"nodes"`
+		}
+		if err := json.Unmarshal(page, &conn); err != nil {
+			return err
+		}
+		for _, n := range conn.Nodes {
+			iids = append(iids, n.IID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("GraphQL.Paginate returned error: %v", err)
+	}
+
+	want := []string{"1", "2"}
+	if !reflect.DeepEqual(want, iids) {
+		t.Errorf("GraphQL.Paginate returned %v, want %v", iids, want)
+	}
+	if _, ok := q.Variables["after"]; ok {
+		t.Errorf("GraphQL.Paginate modified the variables of the query")
+	}
+}
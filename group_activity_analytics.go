@@ -0,0 +1,103 @@
+package gitlab
+
+// GroupActivityAnalyticsService handles communication with the group
+// activity analytics related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_activity_analytics.html
+type GroupActivityAnalyticsService struct {
+	client *Client
+}
+
+// IssuesCount represents the number of recently created issues in a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_activity_analytics.html#get-count-of-recently-created-issues-for-group
+type IssuesCount struct {
+	IssuesCount int `json:"issues_count"`
+}
+
+// MergeRequestsCount represents the number of recently created merge
+// requests in a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_activity_analytics.html#get-count-of-recently-created-merge-requests-for-group
+type MergeRequestsCount struct {
+	MergeRequestsCount int `json:"merge_requests_count"`
+}
+
+// NewMembersCount represents the number of members recently added to a
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_activity_analytics.html#get-count-of-members-recently-added-to-group
+type NewMembersCount struct {
+	NewMembersCount int `json:"new_members_count"`
+}
+
+// GetGroupActivityOptions represents the available options of the group
+// activity analytics methods.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_activity_analytics.html
+type GetGroupActivityOptions struct {
+	GroupPath *string `url:"group_path,omitempty" json:"group_path,omitempty"`
+}
+
+// GetRecentlyCreatedIssuesCount gets the number of issues created in the
+// group in the last 90 days.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_activity_analytics.html#get-count-of-recently-created-issues-for-group
+func (s *GroupActivityAnalyticsService) GetRecentlyCreatedIssuesCount(opt *GetGroupActivityOptions, options ...OptionFunc) (*IssuesCount, *Response, error) {
+	req, err := s.client.NewRequest("GET", "analytics/group_activity/issues_count", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ic := new(IssuesCount)
+	resp, err := s.client.Do(req, ic)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ic, resp, err
+}
+
+// GetRecentlyCreatedMergeRequestsCount gets the number of merge requests
+// created in the group in the last 90 days.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_activity_analytics.html#get-count-of-recently-created-merge-requests-for-group
+func (s *GroupActivityAnalyticsService) GetRecentlyCreatedMergeRequestsCount(opt *GetGroupActivityOptions, options ...OptionFunc) (*MergeRequestsCount, *Response, error) {
+	req, err := s.client.NewRequest("GET", "analytics/group_activity/merge_requests_count", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mc := new(MergeRequestsCount)
+	resp, err := s.client.Do(req, mc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mc, resp, err
+}
+
+// GetRecentlyAddedMembersCount gets the number of members added to the
+// group in the last 90 days.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_activity_analytics.html#get-count-of-members-recently-added-to-group
+func (s *GroupActivityAnalyticsService) GetRecentlyAddedMembersCount(opt *GetGroupActivityOptions, options ...OptionFunc) (*NewMembersCount, *Response, error) {
+	req, err := s.client.NewRequest("GET", "analytics/group_activity/new_members_count", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nc := new(NewMembersCount)
+	resp, err := s.client.Do(req, nc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return nc, resp, err
+}
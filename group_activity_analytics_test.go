@@ -0,0 +1,52 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGroupActivityAnalytics(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/analytics/group_activity/issues_count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/analytics/group_activity/issues_count?group_path=gitlab-org")
+		fmt.Fprint(w, `{"issues_count": 10}`)
+	})
+	mux.HandleFunc("/api/v4/analytics/group_activity/merge_requests_count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"merge_requests_count": 12}`)
+	})
+	mux.HandleFunc("/api/v4/analytics/group_activity/new_members_count", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"new_members_count": 3}`)
+	})
+
+	opt := &GetGroupActivityOptions{GroupPath: String("gitlab-org")}
+
+	ic, _, err := client.GroupActivityAnalytics.GetRecentlyCreatedIssuesCount(opt)
+	if err != nil {
+		t.Fatalf("GroupActivityAnalytics.GetRecentlyCreatedIssuesCount returned error: %v", err)
+	}
+	if ic.IssuesCount != 10 {
+		t.Errorf("GroupActivityAnalytics.GetRecentlyCreatedIssuesCount returned %d, want 10", ic.IssuesCount)
+	}
+
+	mc, _, err := client.GroupActivityAnalytics.GetRecentlyCreatedMergeRequestsCount(opt)
+	if err != nil {
+		t.Fatalf("GroupActivityAnalytics.GetRecentlyCreatedMergeRequestsCount returned error: %v", err)
+	}
+	if mc.MergeRequestsCount != 12 {
+		t.Errorf("GroupActivityAnalytics.GetRecentlyCreatedMergeRequestsCount returned %d, want 12", mc.MergeRequestsCount)
+	}
+
+	nc, _, err := client.GroupActivityAnalytics.GetRecentlyAddedMembersCount(opt)
+	if err != nil {
+		t.Fatalf("GroupActivityAnalytics.GetRecentlyAddedMembersCount returned error: %v", err)
+	}
+	if nc.NewMembersCount != 3 {
+		t.Errorf("GroupActivityAnalytics.GetRecentlyAddedMembersCount returned %d, want 3", nc.NewMembersCount)
+	}
+}
@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // GroupIssueBoardsService handles communication with the group issue board
@@ -62,7 +61,7 @@ func (s *GroupIssueBoardsService) ListGroupIssueBoards(gid interface{}, opt *Lis
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/boards", url.QueryEscape(group))
+	u := fmt.Sprintf("groups/%s/boards", pathEscape(group))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -87,7 +86,7 @@ func (s *GroupIssueBoardsService) GetGroupIssueBoard(gid interface{}, board int,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/boards/%d", url.QueryEscape(group), board)
+	u := fmt.Sprintf("groups/%s/boards/%d", pathEscape(group), board)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -119,7 +118,7 @@ func (s *GroupIssueBoardsService) ListGroupIssueBoardLists(gid interface{}, boar
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/boards/%d/lists", url.QueryEscape(group), board)
+	u := fmt.Sprintf("groups/%s/boards/%d/lists", pathEscape(group), board)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -145,7 +144,7 @@ func (s *GroupIssueBoardsService) GetGroupIssueBoardList(gid interface{}, board,
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/boards/%d/lists/%d",
-		url.QueryEscape(group),
+		pathEscape(group),
 		board,
 		list,
 	)
@@ -170,7 +169,7 @@ func (s *GroupIssueBoardsService) GetGroupIssueBoardList(gid interface{}, board,
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/group_boards.html#new-board-list
 type CreateGroupIssueBoardListOptions struct {
-	LabelID *int `url:"label_id" json:"label_id"`
+	LabelID *int `url:"label_id,omitempty" json:"label_id,omitempty"`
 }
 
 // CreateGroupIssueBoardList creates a new issue board list.
@@ -182,7 +181,7 @@ func (s *GroupIssueBoardsService) CreateGroupIssueBoardList(gid interface{}, boa
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/boards/%d/lists", url.QueryEscape(group), board)
+	u := fmt.Sprintf("groups/%s/boards/%d/lists", pathEscape(group), board)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -204,7 +203,7 @@ func (s *GroupIssueBoardsService) CreateGroupIssueBoardList(gid interface{}, boa
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/group_boards.html#edit-board-list
 type UpdateGroupIssueBoardListOptions struct {
-	Position *int `url:"position" json:"position"`
+	Position *int `url:"position,omitempty" json:"position,omitempty"`
 }
 
 // UpdateIssueBoardList updates the position of an existing
@@ -218,7 +217,7 @@ func (s *GroupIssueBoardsService) UpdateIssueBoardList(gid interface{}, board, l
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/boards/%d/lists/%d",
-		url.QueryEscape(group),
+		pathEscape(group),
 		board,
 		list,
 	)
@@ -248,7 +247,7 @@ func (s *GroupIssueBoardsService) DeleteGroupIssueBoardList(gid interface{}, boa
 		return nil, err
 	}
 	u := fmt.Sprintf("groups/%s/boards/%d/lists/%d",
-		url.QueryEscape(group),
+		pathEscape(group),
 		board,
 		list,
 	)
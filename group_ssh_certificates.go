@@ -0,0 +1,113 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// GroupSSHCertificatesService handles communication with the group SSH
+// certificate related methods of the GitLab API. The certificate authorities
+// added to a top-level group are used to authenticate Git access to the
+// projects of the group with SSH certificates.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_ssh_certificates.html
+type GroupSSHCertificatesService struct {
+	client *Client
+}
+
+// GroupSSHCertificate represents a GitLab group SSH certificate authority.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_ssh_certificates.html
+type GroupSSHCertificate struct {
+	ID        int        `json:"id"`
+	Title     string     `json:"title"`
+	Key       string     `json:"key"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (c GroupSSHCertificate) String() string {
+	return Stringify(c)
+}
+
+// ListGroupSSHCertificates gets a list of the SSH certificate authorities of
+// a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_ssh_certificates.html#get-all-ssh-certificates-for-a-particular-group
+func (s *GroupSSHCertificatesService) ListGroupSSHCertificates(gid interface{}, options ...OptionFunc) ([]*GroupSSHCertificate, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/ssh_certificates", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var certs []*GroupSSHCertificate
+	resp, err := s.client.Do(req, &certs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return certs, resp, err
+}
+
+// CreateGroupSSHCertificateOptions represents the available
+// CreateGroupSSHCertificate() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_ssh_certificates.html#create-ssh-certificate
+type CreateGroupSSHCertificateOptions struct {
+	Key   *string `url:"key,omitempty" json:"key,omitempty"`
+	Title *string `url:"title,omitempty" json:"title,omitempty"`
+}
+
+// CreateGroupSSHCertificate adds an SSH certificate authority to a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_ssh_certificates.html#create-ssh-certificate
+func (s *GroupSSHCertificatesService) CreateGroupSSHCertificate(gid interface{}, opt *CreateGroupSSHCertificateOptions, options ...OptionFunc) (*GroupSSHCertificate, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/ssh_certificates", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert := new(GroupSSHCertificate)
+	resp, err := s.client.Do(req, cert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cert, resp, err
+}
+
+// DeleteGroupSSHCertificate removes an SSH certificate authority from a
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_ssh_certificates.html#delete-group-ssh-certificate
+func (s *GroupSSHCertificatesService) DeleteGroupSSHCertificate(gid interface{}, cert int, options ...OptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/ssh_certificates/%d", pathEscape(group), cert)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
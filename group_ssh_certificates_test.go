@@ -0,0 +1,65 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListGroupSSHCertificates(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/ssh_certificates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "title": "ca-2026", "key": "ssh-rsa AAAA"}]`)
+	})
+
+	certs, _, err := client.GroupSSHCertificates.ListGroupSSHCertificates(1)
+	if err != nil {
+		t.Fatalf("GroupSSHCertificates.ListGroupSSHCertificates returned error: %v", err)
+	}
+
+	want := []*GroupSSHCertificate{{ID: 1, Title: "ca-2026", Key: "ssh-rsa AAAA"}}
+	if !reflect.DeepEqual(want, certs) {
+		t.Errorf("GroupSSHCertificates.ListGroupSSHCertificates returned %+v, want %+v", certs, want)
+	}
+}
+
+func TestCreateGroupSSHCertificate(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/ssh_certificates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"ssh-rsa AAAA","title":"ca-2026"}`)
+		fmt.Fprint(w, `{"id": 1, "title": "ca-2026", "key": "ssh-rsa AAAA"}`)
+	})
+
+	opt := &CreateGroupSSHCertificateOptions{Key: String("ssh-rsa AAAA"), Title: String("ca-2026")}
+	cert, _, err := client.GroupSSHCertificates.CreateGroupSSHCertificate(1, opt)
+	if err != nil {
+		t.Fatalf("GroupSSHCertificates.CreateGroupSSHCertificate returned error: %v", err)
+	}
+
+	want := &GroupSSHCertificate{ID: 1, Title: "ca-2026", Key: "ssh-rsa AAAA"}
+	if !reflect.DeepEqual(want, cert) {
+		t.Errorf("GroupSSHCertificates.CreateGroupSSHCertificate returned %+v, want %+v", cert, want)
+	}
+}
+
+func TestDeleteGroupSSHCertificate(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/ssh_certificates/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.GroupSSHCertificates.DeleteGroupSSHCertificate(1, 2)
+	if err != nil {
+		t.Fatalf("GroupSSHCertificates.DeleteGroupSSHCertificate returned error: %v", err)
+	}
+}
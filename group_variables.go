@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // GroupVariablesService handles communication with the
@@ -53,7 +52,7 @@ func (s *GroupVariablesService) ListVariables(gid interface{}, options ...Option
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/variables", url.QueryEscape(group))
+	u := fmt.Sprintf("groups/%s/variables", pathEscape(group))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -78,7 +77,7 @@ func (s *GroupVariablesService) GetVariable(gid interface{}, key string, options
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/variables/%s", url.QueryEscape(group), url.QueryEscape(key))
+	u := fmt.Sprintf("groups/%s/variables/%s", pathEscape(group), pathEscape(key))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -103,7 +102,7 @@ func (s *GroupVariablesService) CreateVariable(gid interface{}, opt *CreateVaria
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/variables", url.QueryEscape(group))
+	u := fmt.Sprintf("groups/%s/variables", pathEscape(group))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -130,8 +129,8 @@ func (s *GroupVariablesService) UpdateVariable(gid interface{}, key string, opt
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("groups/%s/variables/%s",
-		url.QueryEscape(group),
-		url.QueryEscape(key),
+		pathEscape(group),
+		pathEscape(key),
 	)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
@@ -158,8 +157,8 @@ func (s *GroupVariablesService) RemoveVariable(gid interface{}, key string, opti
 		return nil, err
 	}
 	u := fmt.Sprintf("groups/%s/variables/%s",
-		url.QueryEscape(group),
-		url.QueryEscape(key),
+		pathEscape(group),
+		pathEscape(key),
 	)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
@@ -0,0 +1,226 @@
+package gitlab
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultWalkGroupParallelism is the number of concurrent requests used by
+// WalkGroup when no parallelism is given.
+const defaultWalkGroupParallelism = 4
+
+// WalkGroupOptions represents the available WalkGroup() options.
+type WalkGroupOptions struct {
+	// Parallelism is the maximum number of requests sent concurrently. It
+	// defaults to 4.
+	Parallelism int
+
+	// IncludeGroup filters the descendant groups. Groups for which it returns
+	// false are skipped together with all their subgroups and projects. All
+	// groups are included if nil.
+	IncludeGroup func(*Group) bool
+
+	// IncludeProject filters the projects. All projects are included if nil.
+	IncludeProject func(*Project) bool
+
+	// ListProjectsOptions are used to list the projects of every group, for
+	// example to exclude archived projects. The pagination options are
+	// ignored.
+	ListProjectsOptions *ListGroupProjectsOptions
+}
+
+// WalkGroupFunc is the type of the function called by WalkGroup for every
+// group and project. Either the group or the project is set.
+type WalkGroupFunc func(g *Group, p *Project) error
+
+// WalkGroup walks the group tree rooted at gid, calling fn for the root
+// group and every descendant group and project. Groups are listed
+// concurrently, but fn is never called concurrently. The walk stops at the
+// first error returned by fn or the API.
+//
+// Requests that are rate limited are retried once the rate limit is reset,
+// until ctx is done.
+//
+// Example usage:
+//
+//	err := git.Groups.WalkGroup(ctx, "my-group", func(g *gitlab.Group, p *gitlab.Project) error {
+//		if p != nil {
+//			fmt.Println(p.PathWithNamespace)
+//		}
+//		return nil
+//	}, &gitlab.WalkGroupOptions{
+//		ListProjectsOptions: &gitlab.ListGroupProjectsOptions{Archived: gitlab.Bool(false)},
+//	})
+func (s *GroupsService) WalkGroup(ctx context.Context, gid interface{}, fn WalkGroupFunc, opt *WalkGroupOptions, options ...OptionFunc) error {
+	if opt == nil {
+		opt = new(WalkGroupOptions)
+	}
+	parallelism := opt.Parallelism
+	if parallelism <= 0 {
+		parallelism = defaultWalkGroupParallelism
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := &groupWalker{
+		s:       s,
+		ctx:     ctx,
+		cancel:  cancel,
+		fn:      fn,
+		opt:     opt,
+		options: append(options[:len(options):len(options)], WithContext(ctx)),
+		sem:     make(chan struct{}, parallelism),
+	}
+
+	var root *Group
+	err := w.retry(func() (*Response, error) {
+		var resp *Response
+		var err error
+		root, resp, err = s.GetGroup(gid, w.options...)
+		return resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	w.wg.Add(1)
+	w.walk(root)
+	w.wg.Wait()
+
+	return w.err
+}
+
+// groupWalker keeps track of the state of a WalkGroup call.
+type groupWalker struct {
+	s       *GroupsService
+	ctx     context.Context
+	cancel  context.CancelFunc
+	fn      WalkGroupFunc
+	opt     *WalkGroupOptions
+	options []OptionFunc
+
+	// sem limits the number of concurrent requests.
+	sem chan struct{}
+	wg  sync.WaitGroup
+
+	// mu serializes the calls of fn and protects err.
+	mu  sync.Mutex
+	err error
+}
+
+// walk visits the group, its projects and, concurrently, its subgroups.
+func (w *groupWalker) walk(g *Group) {
+	defer w.wg.Done()
+
+	if err := w.call(g, nil); err != nil {
+		w.fail(err)
+		return
+	}
+
+	po := ListGroupProjectsOptions{}
+	if w.opt.ListProjectsOptions != nil {
+		po = *w.opt.ListProjectsOptions
+	}
+	po.ListOptions = ListOptions{PerPage: 100}
+	for {
+		var ps []*Project
+		var resp *Response
+		err := w.retry(func() (*Response, error) {
+			var err error
+			ps, resp, err = w.s.ListGroupProjects(g.ID, &po, w.options...)
+			return resp, err
+		})
+		if err != nil {
+			w.fail(err)
+			return
+		}
+		for _, p := range ps {
+			if w.opt.IncludeProject != nil && !w.opt.IncludeProject(p) {
+				continue
+			}
+			if err := w.call(nil, p); err != nil {
+				w.fail(err)
+				return
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		po.Page = resp.NextPage
+	}
+
+	so := &ListSubgroupsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		var gs []*Group
+		var resp *Response
+		err := w.retry(func() (*Response, error) {
+			var err error
+			gs, resp, err = w.s.ListSubgroups(g.ID, so, w.options...)
+			return resp, err
+		})
+		if err != nil {
+			w.fail(err)
+			return
+		}
+		for _, sg := range gs {
+			if w.opt.IncludeGroup != nil && !w.opt.IncludeGroup(sg) {
+				continue
+			}
+			w.wg.Add(1)
+			go w.walk(sg)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		so.Page = resp.NextPage
+	}
+}
+
+// call calls fn, unless the walk already failed.
+func (w *groupWalker) call(g *Group, p *Project) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+	return w.fn(g, p)
+}
+
+// fail records the first error of the walk and stops all pending requests.
+func (w *groupWalker) fail(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err == nil {
+		w.err = err
+		w.cancel()
+	}
+}
+
+// retry sends a request within the parallelism limit, and retries it when it
+// is rate limited.
+func (w *groupWalker) retry(do func() (*Response, error)) error {
+	for {
+		select {
+		case w.sem <- struct{}{}:
+		case <-w.ctx.Done():
+			return w.ctx.Err()
+		}
+		resp, err := do()
+		<-w.sem
+
+		if !IsRateLimited(err) || resp == nil {
+			return err
+		}
+
+		wait, ok := rateLimitWait(resp.Header)
+		if !ok {
+			wait = defaultThrottleDuration
+		}
+		if err := sleepContext(w.ctx, wait); err != nil {
+			return err
+		}
+	}
+}
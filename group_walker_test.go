@@ -0,0 +1,96 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWalkGroup(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "full_path": "root"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"id": 11, "path_with_namespace": "root/b"}]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[{"id": 10, "path_with_namespace": "root/a", "archived": true}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/subgroups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 2, "full_path": "root/sub"}, {"id": 3, "full_path": "root/skipped"}]`)
+	})
+
+	var limited int32
+	mux.HandleFunc("/api/v4/groups/2/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if atomic.AddInt32(&limited, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 20, "path_with_namespace": "root/sub/c"}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/2/subgroups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/api/v4/groups/3/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Excluded group was walked: %s", r.URL.Path)
+	})
+
+	var visited []string
+	err := client.Groups.WalkGroup(context.Background(), 1, func(g *Group, p *Project) error {
+		if g != nil {
+			visited = append(visited, "group:"+g.FullPath)
+		} else {
+			visited = append(visited, "project:"+p.PathWithNamespace)
+		}
+		return nil
+	}, &WalkGroupOptions{
+		Parallelism:    2,
+		IncludeGroup:   func(g *Group) bool { return g.ID != 3 },
+		IncludeProject: func(p *Project) bool { return !p.Archived },
+	})
+	if err != nil {
+		t.Fatalf("Groups.WalkGroup returned error: %v", err)
+	}
+
+	sort.Strings(visited)
+	want := []string{"group:root", "group:root/sub", "project:root/b", "project:root/sub/c"}
+	if !reflect.DeepEqual(want, visited) {
+		t.Errorf("Groups.WalkGroup visited %v, want %v", visited, want)
+	}
+}
+
+func TestWalkGroupCallbackError(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Projects were listed after the callback failed")
+	})
+
+	stop := errors.New("stop")
+	err := client.Groups.WalkGroup(context.Background(), 1, func(g *Group, p *Project) error {
+		return stop
+	}, nil)
+	if err != stop {
+		t.Errorf("Groups.WalkGroup returned %v, want %v", err, stop)
+	}
+}
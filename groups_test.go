@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -97,6 +98,29 @@ func TestTransferGroup(t *testing.T) {
 
 }
 
+func TestListGroupTransferLocations(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/transfer_locations",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testURL(t, r, "/api/v4/groups/1/transfer_locations?page=2")
+			fmt.Fprint(w, `[{"id": 27, "name": "Platform", "full_path": "acme/platform"}]`)
+		})
+
+	opt := &ListGroupTransferLocationsOptions{ListOptions: ListOptions{Page: 2}}
+	groups, _, err := client.Groups.ListGroupTransferLocations(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.ListGroupTransferLocations returned error: %v", err)
+	}
+
+	want := []*Group{{ID: 27, Name: "Platform", FullPath: "acme/platform"}}
+	if !reflect.DeepEqual(want, groups) {
+		t.Errorf("Groups.ListGroupTransferLocations returned %+v, want %+v", groups, want)
+	}
+}
+
 func TestDeleteGroup(t *testing.T) {
 	mux, server, client := setup()
 	defer teardown(server)
@@ -204,3 +228,215 @@ func TestListSubgroups(t *testing.T) {
 		t.Errorf("Groups.ListSubgroups returned %+v, want %+v", groups, want)
 	}
 }
+
+func TestUpdateGroupDefaultBranchProtection(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			testBody(t, r, `{"default_branch_protection":2}`)
+			fmt.Fprint(w, `{"id": 1, "default_branch_protection": 2}`)
+		})
+
+	opt := &UpdateGroupOptions{DefaultBranchProtection: BranchProtection(FullBranchProtection)}
+	group, _, err := client.Groups.UpdateGroup(1, opt)
+	if err != nil {
+		t.Errorf("Groups.UpdateGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, DefaultBranchProtection: FullBranchProtection}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestUpdateGroupSettings(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			testBody(t, r, `{"default_branch_protection_defaults":{"allowed_to_push":[{"access_level":40}],"allow_force_push":false,"allowed_to_merge":[{"access_level":30}]},"shared_runners_setting":"disabled_and_overridable","auto_devops_enabled":false}`)
+			fmt.Fprint(w, `{
+				"id": 1,
+				"default_branch_protection_defaults": {
+					"allowed_to_push": [{"access_level": 40}],
+					"allow_force_push": false,
+					"allowed_to_merge": [{"access_level": 30}],
+					"developer_can_initial_push": false
+				},
+				"shared_runners_setting": "disabled_and_overridable",
+				"auto_devops_enabled": false
+			}`)
+		})
+
+	opt := &UpdateGroupOptions{
+		DefaultBranchProtectionDefaults: &DefaultBranchProtectionDefaultsOptions{
+			AllowedToPush:  &[]*GroupAccessLevel{{AccessLevel: MaintainerPermissions}},
+			AllowForcePush: Bool(false),
+			AllowedToMerge: &[]*GroupAccessLevel{{AccessLevel: DeveloperPermissions}},
+		},
+		SharedRunnersSetting: SharedRunnersSetting(DisabledAndOverridableSharedRunnersSetting),
+		AutoDevopsEnabled:    Bool(false),
+	}
+	group, _, err := client.Groups.UpdateGroup(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.UpdateGroup returned error: %v", err)
+	}
+
+	want := &Group{
+		ID: 1,
+		DefaultBranchProtectionDefaults: &BranchProtectionDefaults{
+			AllowedToPush:  []*GroupAccessLevel{{AccessLevel: MaintainerPermissions}},
+			AllowedToMerge: []*GroupAccessLevel{{AccessLevel: DeveloperPermissions}},
+		},
+		SharedRunnersSetting: DisabledAndOverridableSharedRunnersSetting,
+	}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.UpdateGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestAddGroupPushRule(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/push_rule",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"prevent_secrets":true,"branch_name_regex":"^(feature|fix)/"}`)
+			fmt.Fprint(w, `{"id": 1, "prevent_secrets": true, "branch_name_regex": "^(feature|fix)/"}`)
+		})
+
+	opt := &AddGroupPushRuleOptions{
+		PreventSecrets:  Bool(true),
+		BranchNameRegex: String("^(feature|fix)/"),
+	}
+	rule, _, err := client.Groups.AddGroupPushRule(1, opt)
+	if err != nil {
+		t.Errorf("Groups.AddGroupPushRule returned error: %v", err)
+	}
+
+	want := &GroupPushRules{ID: 1, PreventSecrets: true, BranchNameRegex: "^(feature|fix)/"}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("Groups.AddGroupPushRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestShareGroupWithGroup(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/share", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"group_id":4,"group_access":20}`)
+		fmt.Fprint(w, `{"id": 1, "shared_with_groups": [{"group_id": 4, "group_name": "reporters", "group_full_path": "org/reporters", "group_access_level": 20}]}`)
+	})
+
+	opt := &ShareGroupWithGroupOptions{
+		GroupID:     Int(4),
+		GroupAccess: AccessLevel(ReporterPermissions),
+	}
+	group, _, err := client.Groups.ShareGroupWithGroup(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.ShareGroupWithGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, SharedWithGroups: []*SharedWithGroup{{
+		GroupID:          4,
+		GroupName:        "reporters",
+		GroupFullPath:    "org/reporters",
+		GroupAccessLevel: 20,
+	}}}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.ShareGroupWithGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestUnshareGroupFromGroup(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/share/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Groups.UnshareGroupFromGroup(1, 4)
+	if err != nil {
+		t.Fatalf("Groups.UnshareGroupFromGroup returned error: %v", err)
+	}
+}
+
+func TestUploadGroupAvatar(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if _, h, err := r.FormFile("avatar"); err != nil || h.Filename != "logo.png" {
+			t.Errorf("Groups.UploadAvatar request has no logo.png avatar file: %v", err)
+		}
+		fmt.Fprint(w, `{"id": 1, "avatar_url": "http://example.com/uploads/-/system/group/avatar/1/logo.png"}`)
+	})
+
+	group, _, err := client.Groups.UploadAvatar(1, strings.NewReader("png"), "logo.png")
+	if err != nil {
+		t.Fatalf("Groups.UploadAvatar returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, AvatarURL: "http://example.com/uploads/-/system/group/avatar/1/logo.png"}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.UploadAvatar returned %+v, want %+v", group, want)
+	}
+}
+
+func TestRemoveGroupAvatar(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, "avatar=")
+		fmt.Fprint(w, `{"id": 1, "avatar_url": ""}`)
+	})
+
+	group, _, err := client.Groups.RemoveAvatar(1)
+	if err != nil {
+		t.Fatalf("Groups.RemoveAvatar returned error: %v", err)
+	}
+
+	want := &Group{ID: 1}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.RemoveAvatar returned %+v, want %+v", group, want)
+	}
+}
+
+func TestListBillableGroupMembers(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/billable_members?search=john&sort=last_activity_on_desc")
+		w.Header().Set("X-Total", "12")
+		fmt.Fprint(w, `[{"id": 1, "username": "john_doe", "name": "John Doe", "membership_type": "group_member", "removable": true}]`)
+	})
+
+	opt := &ListBillableGroupMembersOptions{Search: String("john"), Sort: String("last_activity_on_desc")}
+	members, resp, err := client.Groups.ListBillableGroupMembers(1, opt)
+	if err != nil {
+		t.Fatalf("Groups.ListBillableGroupMembers returned error: %v", err)
+	}
+
+	want := []*BillableGroupMember{{ID: 1, Username: "john_doe", Name: "John Doe", MembershipType: "group_member", Removable: true}}
+	if !reflect.DeepEqual(want, members) {
+		t.Errorf("Groups.ListBillableGroupMembers returned %+v, want %+v", members, want)
+	}
+	if resp.TotalItems != 12 {
+		t.Errorf("Groups.ListBillableGroupMembers returned %d total items, want %d", resp.TotalItems, 12)
+	}
+}
@@ -0,0 +1,186 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ImportService handles communication with the import related methods of
+// the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/import.html
+type ImportService struct {
+	client *Client
+}
+
+// GitHubImport represents the response from an import from GitHub.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/import.html#import-repository-from-github
+type GitHubImport struct {
+	ID                    int    `json:"id"`
+	Name                  string `json:"name"`
+	FullPath              string `json:"full_path"`
+	FullName              string `json:"full_name"`
+	RefsURL               string `json:"refs_url"`
+	ImportSource          string `json:"import_source"`
+	ImportStatus          string `json:"import_status"`
+	HumanImportStatusName string `json:"human_import_status_name"`
+	ProviderLink          string `json:"provider_link"`
+	RelationType          string `json:"relation_type"`
+	ImportWarning         string `json:"import_warning"`
+}
+
+func (s GitHubImport) String() string {
+	return Stringify(s)
+}
+
+// GitHubImportOptionalStages represents the optional stages of an import
+// from GitHub.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/import.html#import-repository-from-github
+type GitHubImportOptionalStages struct {
+	SingleEndpointIssueEventsImport *bool `url:"single_endpoint_issue_events_import,omitempty" json:"single_endpoint_issue_events_import,omitempty"`
+	SingleEndpointNotesImport       *bool `url:"single_endpoint_notes_import,omitempty" json:"single_endpoint_notes_import,omitempty"`
+	AttachmentsImport               *bool `url:"attachments_import,omitempty" json:"attachments_import,omitempty"`
+	CollaboratorsImport             *bool `url:"collaborators_import,omitempty" json:"collaborators_import,omitempty"`
+}
+
+// ImportRepositoryFromGitHubOptions represents the available
+// ImportRepositoryFromGitHub() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/import.html#import-repository-from-github
+type ImportRepositoryFromGitHubOptions struct {
+	PersonalAccessToken *string                     `url:"personal_access_token,omitempty" json:"personal_access_token,omitempty"`
+	RepoID              *int                        `url:"repo_id,omitempty" json:"repo_id,omitempty"`
+	NewName             *string                     `url:"new_name,omitempty" json:"new_name,omitempty"`
+	TargetNamespace     *string                     `url:"target_namespace,omitempty" json:"target_namespace,omitempty"`
+	GitHubHostname      *string                     `url:"github_hostname,omitempty" json:"github_hostname,omitempty"`
+	OptionalStages      *GitHubImportOptionalStages `url:"optional_stages,omitempty" json:"optional_stages,omitempty"`
+	TimeoutStrategy     *string                     `url:"timeout_strategy,omitempty" json:"timeout_strategy,omitempty"`
+}
+
+// ImportRepositoryFromGitHub starts the import of a GitHub repository into
+// a new GitLab project. The import runs in the background, use
+// GetImportStatus() to follow its progress.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/import.html#import-repository-from-github
+func (s *ImportService) ImportRepositoryFromGitHub(opt *ImportRepositoryFromGitHubOptions, options ...OptionFunc) (*GitHubImport, *Response, error) {
+	req, err := s.client.NewRequest("POST", "import/github", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gi := new(GitHubImport)
+	resp, err := s.client.Do(req, gi)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gi, resp, err
+}
+
+// BitbucketServerImport represents the response from an import from
+// Bitbucket Server.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/import.html#import-repository-from-bitbucket-server
+type BitbucketServerImport struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	FullPath string `json:"full_path"`
+	FullName string `json:"full_name"`
+	RefsURL  string `json:"refs_url"`
+}
+
+func (s BitbucketServerImport) String() string {
+	return Stringify(s)
+}
+
+// ImportRepositoryFromBitbucketServerOptions represents the available
+// ImportRepositoryFromBitbucketServer() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/import.html#import-repository-from-bitbucket-server
+type ImportRepositoryFromBitbucketServerOptions struct {
+	BitbucketServerURL      *string `url:"bitbucket_server_url,omitempty" json:"bitbucket_server_url,omitempty"`
+	BitbucketServerUsername *string `url:"bitbucket_server_username,omitempty" json:"bitbucket_server_username,omitempty"`
+	PersonalAccessToken     *string `url:"personal_access_token,omitempty" json:"personal_access_token,omitempty"`
+	BitbucketServerProject  *string `url:"bitbucket_server_project,omitempty" json:"bitbucket_server_project,omitempty"`
+	BitbucketServerRepo     *string `url:"bitbucket_server_repo,omitempty" json:"bitbucket_server_repo,omitempty"`
+	NewName                 *string `url:"new_name,omitempty" json:"new_name,omitempty"`
+	NewNamespace            *string `url:"new_namespace,omitempty" json:"new_namespace,omitempty"`
+}
+
+// ImportRepositoryFromBitbucketServer starts the import of a Bitbucket
+// Server repository into a new GitLab project. The import runs in the
+// background, use GetImportStatus() to follow its progress.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/import.html#import-repository-from-bitbucket-server
+func (s *ImportService) ImportRepositoryFromBitbucketServer(opt *ImportRepositoryFromBitbucketServerOptions, options ...OptionFunc) (*BitbucketServerImport, *Response, error) {
+	req, err := s.client.NewRequest("POST", "import/bitbucket_server", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bsi := new(BitbucketServerImport)
+	resp, err := s.client.Do(req, bsi)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bsi, resp, err
+}
+
+// ImportStatus represents the import status of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-status
+type ImportStatus struct {
+	ID                int        `json:"id"`
+	Description       string     `json:"description"`
+	Name              string     `json:"name"`
+	NameWithNamespace string     `json:"name_with_namespace"`
+	Path              string     `json:"path"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+	CreatedAt         *time.Time `json:"created_at"`
+	ImportStatus      string     `json:"import_status"`
+	ImportType        string     `json:"import_type"`
+	CorrelationID     string     `json:"correlation_id"`
+	ImportError       string     `json:"import_error"`
+}
+
+func (s ImportStatus) String() string {
+	return Stringify(s)
+}
+
+// GetImportStatus gets the status of the import of a project. The
+// ImportStatus field is one of "none", "scheduled", "started", "finished"
+// or "failed".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-status
+func (s *ImportService) GetImportStatus(pid interface{}, options ...OptionFunc) (*ImportStatus, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/import", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	is := new(ImportStatus)
+	resp, err := s.client.Do(req, is)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return is, resp, err
+}
@@ -0,0 +1,83 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestImportRepositoryFromGitHub(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/import/github", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"personal_access_token":"token","repo_id":34,"target_namespace":"root","optional_stages":{"attachments_import":true}}`)
+		fmt.Fprint(w, `{"id": 27, "name": "my-repo", "full_path": "/root/my-repo", "full_name": "Administrator / my-repo"}`)
+	})
+
+	opt := &ImportRepositoryFromGitHubOptions{
+		PersonalAccessToken: String("token"),
+		RepoID:              Int(34),
+		TargetNamespace:     String("root"),
+		OptionalStages:      &GitHubImportOptionalStages{AttachmentsImport: Bool(true)},
+	}
+	gi, _, err := client.Import.ImportRepositoryFromGitHub(opt)
+	if err != nil {
+		t.Fatalf("Import.ImportRepositoryFromGitHub returned error: %v", err)
+	}
+
+	want := &GitHubImport{ID: 27, Name: "my-repo", FullPath: "/root/my-repo", FullName: "Administrator / my-repo"}
+	if !reflect.DeepEqual(want, gi) {
+		t.Errorf("Import.ImportRepositoryFromGitHub returned %+v, want %+v", gi, want)
+	}
+}
+
+func TestImportRepositoryFromBitbucketServer(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/import/bitbucket_server", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"bitbucket_server_url":"https://bitbucket.example.com","bitbucket_server_username":"user","personal_access_token":"token","bitbucket_server_project":"PRJ","bitbucket_server_repo":"repo"}`)
+		fmt.Fprint(w, `{"id": 28, "name": "repo", "full_path": "/root/repo", "full_name": "Administrator / repo"}`)
+	})
+
+	opt := &ImportRepositoryFromBitbucketServerOptions{
+		BitbucketServerURL:      String("https://bitbucket.example.com"),
+		BitbucketServerUsername: String("user"),
+		PersonalAccessToken:     String("token"),
+		BitbucketServerProject:  String("PRJ"),
+		BitbucketServerRepo:     String("repo"),
+	}
+	bsi, _, err := client.Import.ImportRepositoryFromBitbucketServer(opt)
+	if err != nil {
+		t.Fatalf("Import.ImportRepositoryFromBitbucketServer returned error: %v", err)
+	}
+
+	want := &BitbucketServerImport{ID: 28, Name: "repo", FullPath: "/root/repo", FullName: "Administrator / repo"}
+	if !reflect.DeepEqual(want, bsi) {
+		t.Errorf("Import.ImportRepositoryFromBitbucketServer returned %+v, want %+v", bsi, want)
+	}
+}
+
+func TestGetImportStatus(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "name": "repo", "import_status": "started", "import_type": "github"}`)
+	})
+
+	is, _, err := client.Import.GetImportStatus(1)
+	if err != nil {
+		t.Fatalf("Import.GetImportStatus returned error: %v", err)
+	}
+
+	want := &ImportStatus{ID: 1, Name: "repo", ImportStatus: "started", ImportType: "github"}
+	if !reflect.DeepEqual(want, is) {
+		t.Errorf("Import.GetImportStatus returned %+v, want %+v", is, want)
+	}
+}
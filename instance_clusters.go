@@ -0,0 +1,145 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// InstanceClustersService handles communication with the instance clusters
+// related methods of the GitLab API. These methods are only available for
+// administrators.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/instance_clusters.html
+type InstanceClustersService struct {
+	client *Client
+}
+
+// InstanceCluster represents a GitLab instance cluster.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/instance_clusters.html
+type InstanceCluster struct {
+	ID                 int                 `json:"id"`
+	Name               string              `json:"name"`
+	Domain             string              `json:"domain"`
+	Enabled            bool                `json:"enabled"`
+	Managed            bool                `json:"managed"`
+	CreatedAt          *time.Time          `json:"created_at"`
+	ProviderType       string              `json:"provider_type"`
+	PlatformType       string              `json:"platform_type"`
+	EnvironmentScope   string              `json:"environment_scope"`
+	ClusterType        string              `json:"cluster_type"`
+	User               *User               `json:"user"`
+	PlatformKubernetes *PlatformKubernetes `json:"platform_kubernetes"`
+	ManagementProject  *ManagementProject  `json:"management_project"`
+}
+
+func (v InstanceCluster) String() string {
+	return Stringify(v)
+}
+
+// ListClusters gets a list of all instance clusters.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/instance_clusters.html#list-instance-clusters
+func (s *InstanceClustersService) ListClusters(options ...OptionFunc) ([]*InstanceCluster, *Response, error) {
+	req, err := s.client.NewRequest("GET", "admin/clusters", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ics []*InstanceCluster
+	resp, err := s.client.Do(req, &ics)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ics, resp, err
+}
+
+// GetCluster gets a single instance cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/instance_clusters.html#get-a-single-instance-cluster
+func (s *InstanceClustersService) GetCluster(cluster int, options ...OptionFunc) (*InstanceCluster, *Response, error) {
+	u := fmt.Sprintf("admin/clusters/%d", cluster)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ic := new(InstanceCluster)
+	resp, err := s.client.Do(req, ic)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ic, resp, err
+}
+
+// AddInstanceClusterOptions represents the available AddCluster() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/instance_clusters.html#add-existing-instance-cluster
+type AddInstanceClusterOptions AddClusterOptions
+
+// AddCluster adds an existing Kubernetes instance cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/instance_clusters.html#add-existing-instance-cluster
+func (s *InstanceClustersService) AddCluster(opt *AddInstanceClusterOptions, options ...OptionFunc) (*InstanceCluster, *Response, error) {
+	req, err := s.client.NewRequest("POST", "admin/clusters/add", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ic := new(InstanceCluster)
+	resp, err := s.client.Do(req, ic)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ic, resp, err
+}
+
+// EditInstanceClusterOptions represents the available EditCluster() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/instance_clusters.html#edit-instance-cluster
+type EditInstanceClusterOptions EditClusterOptions
+
+// EditCluster updates an existing instance cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/instance_clusters.html#edit-instance-cluster
+func (s *InstanceClustersService) EditCluster(cluster int, opt *EditInstanceClusterOptions, options ...OptionFunc) (*InstanceCluster, *Response, error) {
+	u := fmt.Sprintf("admin/clusters/%d", cluster)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ic := new(InstanceCluster)
+	resp, err := s.client.Do(req, ic)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ic, resp, err
+}
+
+// DeleteCluster deletes an existing instance cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/instance_clusters.html#delete-instance-cluster
+func (s *InstanceClustersService) DeleteCluster(cluster int, options ...OptionFunc) (*Response, error) {
+	u := fmt.Sprintf("admin/clusters/%d", cluster)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -19,7 +19,6 @@ package gitlab
 import (
 	"encoding/json"
 	"fmt"
-	"net/url"
 	"strings"
 	"time"
 )
@@ -61,6 +60,15 @@ type IssueLinks struct {
 	Project    string `json:"project"`
 }
 
+// IssueEpic represents the epic an issue is assigned to.
+type IssueEpic struct {
+	ID      int    `json:"id"`
+	IID     int    `json:"iid"`
+	GroupID int    `json:"group_id"`
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+}
+
 // Issue represents a GitLab issue.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html
@@ -88,6 +96,9 @@ type Issue struct {
 	TimeStats        *TimeStats       `json:"time_stats"`
 	Confidential     bool             `json:"confidential"`
 	Weight           int              `json:"weight"`
+	HealthStatus     string           `json:"health_status"`
+	Epic             *IssueEpic       `json:"epic"`
+	EpicIID          int              `json:"epic_iid"`
 	DiscussionLocked bool             `json:"discussion_locked"`
 	Links            *IssueLinks      `json:"_links"`
 	IssueLinkID      int              `json:"issue_link_id"`
@@ -121,6 +132,10 @@ type ListIssuesOptions struct {
 	OrderBy         *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort            *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	Search          *string    `url:"search,omitempty" json:"search,omitempty"`
+	Confidential    *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
+	Weight          *int       `url:"weight,omitempty" json:"weight,omitempty"`
+	HealthStatus    *string    `url:"health_status,omitempty" json:"health_status,omitempty"`
+	EpicID          *int       `url:"epic_id,omitempty" json:"epic_id,omitempty"`
 	CreatedAfter    *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
 	CreatedBefore   *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
 	UpdatedAfter    *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
@@ -162,6 +177,10 @@ type ListGroupIssuesOptions struct {
 	OrderBy         *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort            *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	Search          *string    `url:"search,omitempty" json:"search,omitempty"`
+	Confidential    *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
+	Weight          *int       `url:"weight,omitempty" json:"weight,omitempty"`
+	HealthStatus    *string    `url:"health_status,omitempty" json:"health_status,omitempty"`
+	EpicID          *int       `url:"epic_id,omitempty" json:"epic_id,omitempty"`
 	CreatedAfter    *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
 	CreatedBefore   *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
 	UpdatedAfter    *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
@@ -177,7 +196,7 @@ func (s *IssuesService) ListGroupIssues(pid interface{}, opt *ListGroupIssuesOpt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/issues", url.QueryEscape(group))
+	u := fmt.Sprintf("groups/%s/issues", pathEscape(group))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -193,6 +212,56 @@ func (s *IssuesService) ListGroupIssues(pid interface{}, opt *ListGroupIssuesOpt
 	return i, resp, err
 }
 
+// IssuesAnalytics represents the number of issues created per month, keyed
+// by month in the "2006-01" format.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues_analytics.html
+type IssuesAnalytics map[string]int
+
+// GetGroupIssuesAnalyticsOptions represents the available
+// GetGroupIssuesAnalytics() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues_analytics.html
+type GetGroupIssuesAnalyticsOptions struct {
+	State           *string    `url:"state,omitempty" json:"state,omitempty"`
+	Labels          Labels     `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	Milestone       *string    `url:"milestone,omitempty" json:"milestone,omitempty"`
+	AuthorID        *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
+	AssigneeID      *int       `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	MyReactionEmoji *string    `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
+	Search          *string    `url:"search,omitempty" json:"search,omitempty"`
+	CreatedAfter    *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore   *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+	UpdatedAfter    *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
+	UpdatedBefore   *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	MonthsBack      *int       `url:"months_back,omitempty" json:"months_back,omitempty"`
+}
+
+// GetGroupIssuesAnalytics gets the number of issues created per month in a
+// group, using the same filters as ListGroupIssues.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues_analytics.html
+func (s *IssuesService) GetGroupIssuesAnalytics(gid interface{}, opt *GetGroupIssuesAnalyticsOptions, options ...OptionFunc) (IssuesAnalytics, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/issues_analytics", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ia IssuesAnalytics
+	resp, err := s.client.Do(req, &ia)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ia, resp, err
+}
+
 // ListProjectIssuesOptions represents the available ListProjectIssues() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/issues.html#list-project-issues
@@ -209,6 +278,10 @@ type ListProjectIssuesOptions struct {
 	OrderBy         *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort            *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	Search          *string    `url:"search,omitempty" json:"search,omitempty"`
+	Confidential    *bool      `url:"confidential,omitempty" json:"confidential,omitempty"`
+	Weight          *int       `url:"weight,omitempty" json:"weight,omitempty"`
+	HealthStatus    *string    `url:"health_status,omitempty" json:"health_status,omitempty"`
+	EpicID          *int       `url:"epic_id,omitempty" json:"epic_id,omitempty"`
 	CreatedAfter    *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
 	CreatedBefore   *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
 	UpdatedAfter    *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
@@ -224,7 +297,7 @@ func (s *IssuesService) ListProjectIssues(pid interface{}, opt *ListProjectIssue
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/issues", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -248,7 +321,7 @@ func (s *IssuesService) GetIssue(pid interface{}, issue int, options ...OptionFu
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -279,6 +352,7 @@ type CreateIssueOptions struct {
 	MergeRequestToResolveDiscussionsOf *int       `url:"merge_request_to_resolve_discussions_of,omitempty" json:"merge_request_to_resolve_discussions_of,omitempty"`
 	DiscussionToResolve                *string    `url:"discussion_to_resolve,omitempty" json:"discussion_to_resolve,omitempty"`
 	Weight                             *int       `url:"weight,omitempty" json:"weight,omitempty"`
+	EpicID                             *int       `url:"epic_id,omitempty" json:"epic_id,omitempty"`
 }
 
 // CreateIssue creates a new project issue.
@@ -289,7 +363,7 @@ func (s *IssuesService) CreateIssue(pid interface{}, opt *CreateIssueOptions, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/issues", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -319,6 +393,8 @@ type UpdateIssueOptions struct {
 	UpdatedAt        *time.Time `url:"updated_at,omitempty" json:"updated_at,omitempty"`
 	DueDate          *ISOTime   `url:"due_date,omitempty" json:"due_date,omitempty"`
 	Weight           *int       `url:"weight,omitempty" json:"weight,omitempty"`
+	HealthStatus     *string    `url:"health_status,omitempty" json:"health_status,omitempty"`
+	EpicID           *int       `url:"epic_id,omitempty" json:"epic_id,omitempty"`
 	DiscussionLocked *bool      `url:"discussion_locked,omitempty" json:"discussion_locked,omitempty"`
 }
 
@@ -331,7 +407,7 @@ func (s *IssuesService) UpdateIssue(pid interface{}, issue int, opt *UpdateIssue
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -355,7 +431,7 @@ func (s *IssuesService) DeleteIssue(pid interface{}, issue int, options ...Optio
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -376,7 +452,7 @@ func (s *IssuesService) SubscribeToIssue(pid interface{}, issue int, options ...
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/subscribe", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d/subscribe", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -403,7 +479,7 @@ func (s *IssuesService) UnsubscribeFromIssue(pid interface{}, issue int, options
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/unsubscribe", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d/unsubscribe", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -436,7 +512,7 @@ func (s *IssuesService) ListMergeRequestsClosingIssue(pid interface{}, issue int
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("/projects/%s/issues/%d/closed_by", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("/projects/%s/issues/%d/closed_by", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -452,6 +528,31 @@ func (s *IssuesService) ListMergeRequestsClosingIssue(pid interface{}, issue int
 	return m, resp, err
 }
 
+// ListIssueParticipants gets the users participating in an issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/issues.html#participants-on-issues
+func (s *IssuesService) ListIssueParticipants(pid interface{}, issue int, options ...OptionFunc) ([]*BasicUser, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues/%d/participants", pathEscape(project), issue)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bu []*BasicUser
+	resp, err := s.client.Do(req, &bu)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bu, resp, err
+}
+
 // SetTimeEstimate sets the time estimate for a single project issue.
 //
 // GitLab API docs:
@@ -146,6 +146,54 @@ func TestListGroupIssues(t *testing.T) {
 	}
 }
 
+func TestGetGroupIssuesAnalytics(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/issues_analytics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/issues_analytics?labels=bug&months_back=2")
+		fmt.Fprint(w, `{"2018-09": 3, "2018-10": 5}`)
+	})
+
+	opt := &GetGroupIssuesAnalyticsOptions{
+		Labels:     Labels{"bug"},
+		MonthsBack: Int(2),
+	}
+
+	analytics, _, err := client.Issues.GetGroupIssuesAnalytics("1", opt)
+	if err != nil {
+		t.Fatalf("Issues.GetGroupIssuesAnalytics returned error: %v", err)
+	}
+
+	want := IssuesAnalytics{"2018-09": 3, "2018-10": 5}
+	if !reflect.DeepEqual(want, analytics) {
+		t.Errorf("Issues.GetGroupIssuesAnalytics returned %+v, want %+v", analytics, want)
+	}
+}
+
+func TestListConfidentialProjectIssues(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/issues?confidential=true")
+		fmt.Fprint(w, `[{"id":1, "confidential": true}]`)
+	})
+
+	issues, _, err := client.Issues.ListProjectIssues("1", &ListProjectIssuesOptions{Confidential: Bool(true)})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	want := []*Issue{{ID: 1, Confidential: true}}
+
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Issues.ListProjectIssues returned %+v, want %+v", issues, want)
+	}
+}
+
 func TestCreateIssue(t *testing.T) {
 	mux, server, client := setup()
 	defer teardown(server)
@@ -211,6 +259,45 @@ func TestUpdateIssue(t *testing.T) {
 	}
 }
 
+func TestUpdateIssuePlanningFields(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"weight":3,"health_status":"at_risk","epic_id":12}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"weight": 3,
+			"health_status": "at_risk",
+			"epic_iid": 2,
+			"epic": {"id": 12, "iid": 2, "group_id": 7, "title": "Roadmap", "url": "/groups/planning/-/epics/2"}
+		}`)
+	})
+
+	updateIssueOpt := &UpdateIssueOptions{
+		Weight:       Int(3),
+		HealthStatus: String("at_risk"),
+		EpicID:       Int(12),
+	}
+	issue, _, err := client.Issues.UpdateIssue(1, 5, updateIssueOpt)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	want := &Issue{
+		ID:           1,
+		Weight:       3,
+		HealthStatus: "at_risk",
+		EpicIID:      2,
+		Epic:         &IssueEpic{ID: 12, IID: 2, GroupID: 7, Title: "Roadmap", URL: "/groups/planning/-/epics/2"},
+	}
+
+	if !reflect.DeepEqual(want, issue) {
+		t.Errorf("Issues.UpdateIssue returned %+v, want %+v", issue, want)
+	}
+}
+
 func TestSubscribeToIssue(t *testing.T) {
 	mux, server, client := setup()
 	defer teardown(server)
@@ -402,3 +489,26 @@ func TestGetTimeSpent(t *testing.T) {
 		t.Errorf("Issues.GetTimeSpent returned %+v, want %+v", timeState, want)
 	}
 }
+
+func TestListIssueParticipants(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5/participants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "username": "venkatesh", "name": "Venkatesh Thalluri", "state": "active"}, {"id": 2, "username": "jdoe", "name": "John Doe", "state": "active"}]`)
+	})
+
+	participants, _, err := client.Issues.ListIssueParticipants(1, 5)
+	if err != nil {
+		t.Fatalf("Issues.ListIssueParticipants returned error: %v", err)
+	}
+
+	want := []*BasicUser{
+		{ID: 1, Username: "venkatesh", Name: "Venkatesh Thalluri", State: "active"},
+		{ID: 2, Username: "jdoe", Name: "John Doe", State: "active"},
+	}
+	if !reflect.DeepEqual(want, participants) {
+		t.Errorf("Issues.ListIssueParticipants returned %+v, want %+v", participants, want)
+	}
+}
@@ -18,9 +18,10 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
-	"net/url"
+	"net/http"
 	"time"
 )
 
@@ -86,7 +87,7 @@ func (s *JobsService) ListProjectJobs(pid interface{}, opts *ListJobsOptions, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/jobs", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opts, options)
 	if err != nil {
@@ -112,7 +113,7 @@ func (s *JobsService) ListPipelineJobs(pid interface{}, pipelineID int, opts *Li
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipelines/%d/jobs", url.QueryEscape(project), pipelineID)
+	u := fmt.Sprintf("projects/%s/pipelines/%d/jobs", pathEscape(project), pipelineID)
 
 	req, err := s.client.NewRequest("GET", u, opts, options)
 	if err != nil {
@@ -137,7 +138,7 @@ func (s *JobsService) GetJob(pid interface{}, jobID int, options ...OptionFunc)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -162,7 +163,7 @@ func (s *JobsService) GetJobArtifacts(pid interface{}, jobID int, options ...Opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -184,7 +185,7 @@ func (s *JobsService) GetJobArtifacts(pid interface{}, jobID int, options ...Opt
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#download-the-artifacts-file
 type DownloadArtifactsFileOptions struct {
-	Job *string `url:"job" json:"job"`
+	Job *string `url:"job,omitempty" json:"job,omitempty"`
 }
 
 // DownloadArtifactsFile download the artifacts file from the given
@@ -197,7 +198,7 @@ func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", url.QueryEscape(project), refName)
+	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", pathEscape(project), refName)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -228,7 +229,7 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 
 	u := fmt.Sprintf(
 		"projects/%s/jobs/%d/artifacts/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		jobID,
 		artifactPath,
 	)
@@ -256,7 +257,7 @@ func (s *JobsService) GetTraceFile(pid interface{}, jobID int, options ...Option
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d/trace", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d/trace", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -272,6 +273,81 @@ func (s *JobsService) GetTraceFile(pid interface{}, jobID int, options ...Option
 	return traceBuf, resp, err
 }
 
+// FollowJobTraceOptions represents the available FollowJobTrace() options.
+type FollowJobTraceOptions struct {
+	// Interval is the time to wait between fetching new output. Defaults to
+	// 3 seconds.
+	Interval time.Duration
+}
+
+// FollowJobTrace streams the trace of a job to w while the job is running,
+// similar to "tail -f". Only new output is requested on each poll by using
+// the Range header. It returns the job once it has finished and all output
+// is written.
+func (s *JobsService) FollowJobTrace(ctx context.Context, pid interface{}, jobID int, w io.Writer, opt *FollowJobTraceOptions, options ...OptionFunc) (*Job, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/jobs/%d/trace", pathEscape(project), jobID)
+
+	interval := 3 * time.Second
+	if opt != nil && opt.Interval > 0 {
+		interval = opt.Interval
+	}
+
+	options = append(options, WithContext(ctx))
+
+	var offset int64
+	for {
+		// Get the status before the trace, so no output is missed when
+		// the job finishes in between.
+		job, resp, err := s.GetJob(pid, jobID, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		req, err := s.client.NewRequest("GET", u, nil, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		if offset > 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+
+		trace := new(bytes.Buffer)
+		resp, err = s.client.Do(req, trace)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusRequestedRangeNotSatisfiable) {
+			return nil, resp, err
+		}
+
+		data := trace.Bytes()
+		if resp.StatusCode != http.StatusPartialContent {
+			// The full trace was returned, so skip what was already written.
+			if int64(len(data)) > offset {
+				data = data[offset:]
+			} else {
+				data = nil
+			}
+		}
+
+		n, err := w.Write(data)
+		offset += int64(n)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		switch BuildStateValue(job.Status) {
+		case Success, Failed, Canceled, Skipped:
+			return job, resp, nil
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return job, resp, err
+		}
+	}
+}
+
 // CancelJob cancels a single job of a project.
 //
 // GitLab API docs:
@@ -281,7 +357,7 @@ func (s *JobsService) CancelJob(pid interface{}, jobID int, options ...OptionFun
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d/cancel", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d/cancel", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -306,7 +382,7 @@ func (s *JobsService) RetryJob(pid interface{}, jobID int, options ...OptionFunc
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d/retry", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d/retry", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -332,7 +408,7 @@ func (s *JobsService) EraseJob(pid interface{}, jobID int, options ...OptionFunc
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d/erase", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d/erase", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -358,7 +434,7 @@ func (s *JobsService) KeepArtifacts(pid interface{}, jobID int, options ...Optio
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts/keep", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d/artifacts/keep", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -374,18 +450,26 @@ func (s *JobsService) KeepArtifacts(pid interface{}, jobID int, options ...Optio
 	return job, resp, err
 }
 
+// PlayJobOptions represents the available PlayJob() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#play-a-job
+type PlayJobOptions struct {
+	JobVariablesAttributes []*PipelineVariable `url:"job_variables_attributes,omitempty" json:"job_variables_attributes,omitempty"`
+}
+
 // PlayJob triggers a manual action to start a job.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#play-a-job
-func (s *JobsService) PlayJob(pid interface{}, jobID int, options ...OptionFunc) (*Job, *Response, error) {
+func (s *JobsService) PlayJob(pid interface{}, jobID int, opt *PlayJobOptions, options ...OptionFunc) (*Job, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/%d/play", url.QueryEscape(project), jobID)
+	u := fmt.Sprintf("projects/%s/jobs/%d/play", pathEscape(project), jobID)
 
-	req, err := s.client.NewRequest("POST", u, nil, options)
+	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,10 +1,15 @@
 package gitlab
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestListPipelineJobs(t *testing.T) {
@@ -26,3 +31,81 @@ func TestListPipelineJobs(t *testing.T) {
 		t.Errorf("Jobs.ListPipelineJobs returned %+v, want %+v", jobs, want)
 	}
 }
+
+func TestPlayJob(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/3/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"job_variables_attributes":[{"key":"ENVIRONMENT","value":"staging"}]}`)
+		fmt.Fprint(w, `{"id":3}`)
+	})
+
+	opt := &PlayJobOptions{
+		JobVariablesAttributes: []*PipelineVariable{{Key: "ENVIRONMENT", Value: "staging"}},
+	}
+	job, _, err := client.Jobs.PlayJob(1, 3, opt)
+	if err != nil {
+		t.Errorf("Jobs.PlayJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 3}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.PlayJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestFollowJobTrace(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	trace := "line 1\n"
+	status := "running"
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id": 1, "status": %q}`, status)
+	})
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/1/trace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		var offset int
+		if rng := r.Header.Get("Range"); rng != "" {
+			offset, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+		}
+
+		switch {
+		case offset == 0:
+			fmt.Fprint(w, trace)
+		case offset >= len(trace):
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		default:
+			w.WriteHeader(http.StatusPartialContent)
+			fmt.Fprint(w, trace[offset:])
+		}
+
+		// Simulate the job producing more output and finishing.
+		if trace == "line 1\n" {
+			trace += "line 2\n"
+		} else {
+			status = "success"
+		}
+	})
+
+	out := new(bytes.Buffer)
+	opt := &FollowJobTraceOptions{Interval: time.Millisecond}
+
+	job, _, err := client.Jobs.FollowJobTrace(context.Background(), 1, 1, out, opt)
+	if err != nil {
+		t.Fatalf("Jobs.FollowJobTrace returned error: %v", err)
+	}
+
+	if job.Status != "success" {
+		t.Errorf("Job status is %s, want %s", job.Status, "success")
+	}
+	if want := "line 1\nline 2\n"; out.String() != want {
+		t.Errorf("Jobs.FollowJobTrace wrote %q, want %q", out.String(), want)
+	}
+}
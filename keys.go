@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -53,7 +52,7 @@ func (s *KeysService) GetKeyWithUser(kid interface{}, options ...OptionFunc) (*K
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("keys/%s", url.QueryEscape(key))
+	u := fmt.Sprintf("keys/%s", pathEscape(key))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -19,7 +19,6 @@ package gitlab
 import (
 	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 // LabelsService handles communication with the label related methods of the
@@ -82,7 +81,7 @@ func (s *LabelsService) ListLabels(pid interface{}, opt *ListLabelsOptions, opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/labels", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -116,7 +115,7 @@ func (s *LabelsService) CreateLabel(pid interface{}, opt *CreateLabelOptions, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/labels", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -147,7 +146,7 @@ func (s *LabelsService) DeleteLabel(pid interface{}, opt *DeleteLabelOptions, op
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/labels", pathEscape(project))
 
 	req, err := s.client.NewRequest("DELETE", u, opt, options)
 	if err != nil {
@@ -176,7 +175,7 @@ func (s *LabelsService) UpdateLabel(pid interface{}, opt *UpdateLabelOptions, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/labels", pathEscape(project))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -207,7 +206,7 @@ func (s *LabelsService) SubscribeToLabel(pid interface{}, labelID interface{}, o
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels/%s/subscribe", url.QueryEscape(project), label)
+	u := fmt.Sprintf("projects/%s/labels/%s/subscribe", pathEscape(project), pathEscape(label))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -238,7 +237,7 @@ func (s *LabelsService) UnsubscribeFromLabel(pid interface{}, labelID interface{
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/labels/%s/unsubscribe", url.QueryEscape(project), label)
+	u := fmt.Sprintf("projects/%s/labels/%s/unsubscribe", pathEscape(project), pathEscape(label))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -0,0 +1,200 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// lfsPointerVersion is the version line every Git LFS pointer file starts with.
+const lfsPointerVersion = "version https://git-lfs.github.com/spec/v1"
+
+// lfsPointerMaxSize is the maximum size of a Git LFS pointer file. Larger
+// files are never treated as pointer files.
+const lfsPointerMaxSize = 1024
+
+// LFSPointer represents a Git LFS pointer file, which is stored in the
+// repository instead of the actual content of a file tracked by Git LFS.
+//
+// Git LFS docs: https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md
+type LFSPointer struct {
+	OID  string
+	Size int64
+}
+
+func (p LFSPointer) String() string {
+	return Stringify(p)
+}
+
+// ParseLFSPointer parses the content of a file as a Git LFS pointer file. It
+// reports false if the content is not a Git LFS pointer file.
+func ParseLFSPointer(content []byte) (*LFSPointer, bool) {
+	if len(content) > lfsPointerMaxSize || !bytes.HasPrefix(content, []byte(lfsPointerVersion+"\n")) {
+		return nil, false
+	}
+
+	p := new(LFSPointer)
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		kv := strings.SplitN(line, " ", 2)
+		if len(kv) != 2 {
+			return nil, false
+		}
+		switch kv[0] {
+		case "oid":
+			if !strings.HasPrefix(kv[1], "sha256:") {
+				return nil, false
+			}
+			p.OID = strings.TrimPrefix(kv[1], "sha256:")
+		case "size":
+			size, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil || size < 0 {
+				return nil, false
+			}
+			p.Size = size
+		}
+	}
+
+	if p.OID == "" {
+		return nil, false
+	}
+
+	return p, true
+}
+
+// lfsBatchObject represents an object in a request or response of the Git
+// LFS batch API.
+type lfsBatchObject struct {
+	OID     string `json:"oid"`
+	Size    int64  `json:"size"`
+	Actions *struct {
+		Download *struct {
+			Href   string            `json:"href"`
+			Header map[string]string `json:"header"`
+		} `json:"download"`
+	} `json:"actions,omitempty"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// DownloadLFSObject downloads the Git LFS object the pointer refers to and
+// writes it to w. The download location is requested using the Git LFS batch
+// API of the project repository.
+//
+// Git LFS docs: https://github.com/git-lfs/git-lfs/blob/main/docs/api/batch.md
+func (s *RepositoryFilesService) DownloadLFSObject(pid interface{}, pointer *LFSPointer, w io.Writer, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	// The batch API is part of the Git HTTP endpoint of the repository, which
+	// is only reachable using the namespaced path of the project.
+	if _, err := strconv.Atoi(project); err == nil {
+		p, resp, err := s.client.Projects.GetProject(pid, options...)
+		if err != nil {
+			return resp, err
+		}
+		project = p.PathWithNamespace
+	}
+
+	opt := struct {
+		Operation string            `json:"operation"`
+		Transfers []string          `json:"transfers"`
+		Objects   []*lfsBatchObject `json:"objects"`
+	}{
+		Operation: "download",
+		Transfers: []string{"basic"},
+		Objects:   []*lfsBatchObject{{OID: pointer.OID, Size: pointer.Size}},
+	}
+
+	u := project + ".git/info/lfs/objects/batch"
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Git HTTP endpoint lives next to the API instead of below it, and
+	// only accepts basic authentication using the token as the password.
+	req.URL.Path = strings.Replace(req.URL.Path, apiVersionPath, "", 1)
+	if req.URL.RawPath != "" {
+		req.URL.RawPath = strings.Replace(req.URL.RawPath, apiVersionPath, "", 1)
+	}
+	if token := s.client.authToken(req); token != "" {
+		user := "oauth2"
+		if s.client.authType == jobToken {
+			user = "gitlab-ci-token"
+		}
+		removeAuthHeaders(req.Header)
+		req.SetBasicAuth(user, token)
+	}
+	req.Header.Set("Accept", "application/vnd.git-lfs+json")
+	req.Header.Set("Content-Type", "application/vnd.git-lfs+json")
+
+	// The batch request only looks up the download location.
+	markReadOnly(req)
+
+	var batch struct {
+		Objects []*lfsBatchObject `json:"objects"`
+	}
+	resp, err := s.client.Do(req, &batch)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(batch.Objects) != 1 {
+		return resp, fmt.Errorf("gitlab: LFS batch API returned %d objects for %s", len(batch.Objects), pointer.OID)
+	}
+	obj := batch.Objects[0]
+	if obj.Error != nil {
+		return resp, fmt.Errorf("gitlab: LFS object %s: %s (%d)", pointer.OID, obj.Error.Message, obj.Error.Code)
+	}
+	if obj.Actions == nil || obj.Actions.Download == nil {
+		return resp, fmt.Errorf("gitlab: LFS batch API returned no download action for %s", pointer.OID)
+	}
+
+	// The object is usually stored outside of GitLab, so it is downloaded
+	// using only the headers returned by the batch API. The options are only
+	// applied for their context.
+	dl, err := http.NewRequest("GET", obj.Actions.Download.Href, nil)
+	if err != nil {
+		return resp, err
+	}
+	for _, fn := range options {
+		if fn == nil {
+			continue
+		}
+		if err := fn(dl); err != nil {
+			return resp, err
+		}
+	}
+	if cancel, ok := dl.Context().Value(timeoutCancelKey{}).(context.CancelFunc); ok {
+		defer cancel()
+	}
+	dl.Header = make(http.Header)
+	for k, v := range obj.Actions.Download.Header {
+		dl.Header.Set(k, v)
+	}
+	if s.client.UserAgent != "" {
+		dl.Header.Set("User-Agent", s.client.UserAgent)
+	}
+
+	dlResp, err := s.client.client.Do(dl)
+	if err != nil {
+		return nil, err
+	}
+	defer dlResp.Body.Close()
+
+	response := newResponse(dlResp)
+	if err := CheckResponse(dlResp); err != nil {
+		return response, err
+	}
+
+	_, err = io.Copy(w, dlResp.Body)
+	return response, err
+}
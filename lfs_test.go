@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testLFSPointer = `version https://git-lfs.github.com/spec/v1
+oid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393
+size 12345
+`
+
+func TestParseLFSPointer(t *testing.T) {
+	p, ok := ParseLFSPointer([]byte(testLFSPointer))
+	if !ok {
+		t.Fatalf("ParseLFSPointer did not detect the pointer file")
+	}
+
+	want := &LFSPointer{OID: "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393", Size: 12345}
+	if !reflect.DeepEqual(want, p) {
+		t.Errorf("ParseLFSPointer returned %+v, want %+v", p, want)
+	}
+
+	for _, content := range []string{
+		"",
+		"just a regular file\n",
+		"version https://git-lfs.github.com/spec/v1\nsize 12345\n",
+		"version https://git-lfs.github.com/spec/v1\noid md5:abc\nsize 12345\n",
+	} {
+		if _, ok := ParseLFSPointer([]byte(content)); ok {
+			t.Errorf("ParseLFSPointer detected a pointer file in %q", content)
+		}
+	}
+}
+
+func TestGetRawFileResolveLFS(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	client.token = "secret"
+
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/repository/files/image.png/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, testLFSPointer)
+	})
+
+	mux.HandleFunc("/group/project.git/info/lfs/objects/batch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"operation":"download","transfers":["basic"],"objects":[{"oid":"4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393","size":12345}]}`)
+		if _, password, _ := r.BasicAuth(); password != "secret" {
+			t.Errorf("Batch request password is %q, want %q", password, "secret")
+		}
+		fmt.Fprintf(w, `{"objects": [{
+			"oid": "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393",
+			"size": 12345,
+			"actions": {"download": {"href": "%s/lfs/object", "header": {"Authorization": "Basic xyz"}}}
+		}]}`, server.URL)
+	})
+
+	mux.HandleFunc("/lfs/object", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Authorization"); got != "Basic xyz" {
+			t.Errorf("Download request Authorization header is %q, want %q", got, "Basic xyz")
+		}
+		if got := r.Header.Get("X-Request-Tag"); got != "" {
+			t.Errorf("Download request X-Request-Tag header is %q, want none", got)
+		}
+		fmt.Fprint(w, "binary content")
+	})
+
+	opt := &GetRawFileOptions{Ref: String("master"), ResolveLFS: Bool(true)}
+	b, _, err := client.RepositoryFiles.GetRawFile("group/project", "image.png", opt, WithHeader("X-Request-Tag", "test"))
+	if err != nil {
+		t.Fatalf("RepositoryFiles.GetRawFile returned error: %v", err)
+	}
+
+	if string(b) != "binary content" {
+		t.Errorf("RepositoryFiles.GetRawFile returned %q, want %q", b, "binary content")
+	}
+}
+
+func TestDownloadLFSObjectError(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "path_with_namespace": "group/project"}`)
+	})
+
+	mux.HandleFunc("/group/project.git/info/lfs/objects/batch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"objects": [{"oid": "abc", "size": 1, "error": {"code": 404, "message": "Object does not exist"}}]}`)
+	})
+
+	_, err := client.RepositoryFiles.DownloadLFSObject(1, &LFSPointer{OID: "abc", Size: 1}, nil)
+	if err == nil {
+		t.Fatal("RepositoryFiles.DownloadLFSObject returned no error for a missing object")
+	}
+}
@@ -52,8 +52,8 @@ func (l License) String() string {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/license.html#retrieve-information-about-the-current-license
-func (s *LicenseService) GetLicense() (*License, *Response, error) {
-	req, err := s.client.NewRequest("GET", "license", nil, nil)
+func (s *LicenseService) GetLicense(options ...OptionFunc) (*License, *Response, error) {
+	req, err := s.client.NewRequest("GET", "license", nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -71,7 +71,7 @@ func (s *LicenseService) GetLicense() (*License, *Response, error) {
 //
 // https://docs.gitlab.com/ee/api/license.html#add-a-new-license
 type AddLicenseOptions struct {
-	License *string `url:"license" json:"license"`
+	License *string `url:"license,omitempty" json:"license,omitempty"`
 }
 
 // AddLicense adds a new license.
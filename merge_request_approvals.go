@@ -2,7 +2,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -80,11 +79,14 @@ func (m MergeRequestApprovals) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
 type ApproveMergeRequestOptions struct {
-	SHA *string `url:"sha,omitempty" json:"sha,omitempty"`
+	SHA              *string `url:"sha,omitempty" json:"sha,omitempty"`
+	ApprovalPassword *string `url:"approval_password,omitempty" json:"approval_password,omitempty"`
 }
 
 // ApproveMergeRequest approves a merge request on GitLab. If a non-empty sha
-// is provided then it must match the sha at the HEAD of the MR.
+// is provided then it must match the sha at the HEAD of the MR. On projects
+// that require user re-authentication to approve, the password of the user
+// must be provided as approval password.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
@@ -93,7 +95,7 @@ func (s *MergeRequestApprovalsService) ApproveMergeRequest(pid interface{}, mr i
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_requests/%d/approve", url.QueryEscape(project), mr)
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/approve", pathEscape(project), mr)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -118,7 +120,7 @@ func (s *MergeRequestApprovalsService) UnapproveMergeRequest(pid interface{}, mr
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_requests/%d/unapprove", url.QueryEscape(project), mr)
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/unapprove", pathEscape(project), mr)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -0,0 +1,30 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestApproveMergeRequest(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/approve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"sha":"a1b2c3","approval_password":"hunter2"}`)
+		fmt.Fprint(w, `{"approvals_required": 2, "approvals_left": 1}`)
+	})
+
+	opt := &ApproveMergeRequestOptions{SHA: String("a1b2c3"), ApprovalPassword: String("hunter2")}
+	approvals, _, err := client.MergeRequestApprovals.ApproveMergeRequest(1, 5, opt)
+	if err != nil {
+		t.Fatalf("MergeRequestApprovals.ApproveMergeRequest returned error: %v", err)
+	}
+
+	want := &MergeRequestApprovals{ApprovalsRequired: 2, ApprovalsLeft: 1}
+	if !reflect.DeepEqual(want, approvals) {
+		t.Errorf("MergeRequestApprovals.ApproveMergeRequest returned %+v, want %+v", approvals, want)
+	}
+}
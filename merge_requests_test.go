@@ -0,0 +1,177 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWaitForMergeRequestMerged(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			fmt.Fprint(w, `{"iid": 1, "state": "opened", "detailed_merge_status": "ci_still_running"}`)
+			return
+		}
+		fmt.Fprint(w, `{"iid": 1, "state": "merged", "detailed_merge_status": "not_open"}`)
+	})
+
+	opt := &WaitForMergeRequestOptions{Interval: time.Millisecond}
+	mr, _, err := client.MergeRequests.WaitForMergeRequestMerged(context.Background(), 1, 1, opt)
+	if err != nil {
+		t.Fatalf("MergeRequests.WaitForMergeRequestMerged returned error: %v", err)
+	}
+	if mr.State != "merged" {
+		t.Errorf("Merge request state is %s, want %s", mr.State, "merged")
+	}
+}
+
+func TestWaitForMergeableUnmergeable(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"iid": 1, "state": "opened", "detailed_merge_status": "conflict"}`)
+	})
+
+	opt := &WaitForMergeRequestOptions{Interval: time.Millisecond}
+	mr, _, err := client.MergeRequests.WaitForMergeable(context.Background(), 1, 1, opt)
+	if err == nil {
+		t.Fatal("Expected MergeRequests.WaitForMergeable to return an error")
+	}
+	if mr == nil || mr.DetailedMergeStatus != "conflict" {
+		t.Errorf("MergeRequests.WaitForMergeable returned %+v, want detailed merge status %s", mr, "conflict")
+	}
+}
+
+func TestWaitForMergeableNotApproved(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			fmt.Fprint(w, `{"iid": 1, "state": "opened", "detailed_merge_status": "not_approved"}`)
+			return
+		}
+		fmt.Fprint(w, `{"iid": 1, "state": "opened", "detailed_merge_status": "mergeable"}`)
+	})
+
+	opt := &WaitForMergeRequestOptions{Interval: time.Millisecond}
+	mr, _, err := client.MergeRequests.WaitForMergeable(context.Background(), 1, 1, opt)
+	if err != nil {
+		t.Fatalf("MergeRequests.WaitForMergeable returned error: %v", err)
+	}
+	if mr.DetailedMergeStatus != "mergeable" {
+		t.Errorf("Merge request detailed merge status is %s, want %s", mr.DetailedMergeStatus, "mergeable")
+	}
+	if calls != 3 {
+		t.Errorf("MergeRequests.WaitForMergeable polled %d times, want 3", calls)
+	}
+}
+
+func TestUpdateMergeRequestReviewers(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"assignee_ids":[],"reviewer_ids":[2,3]}`)
+		fmt.Fprint(w, `{"id": 1, "iid": 5, "assignees": [], "reviewers": [{"id": 2, "username": "alice"}, {"id": 3, "username": "bob"}]}`)
+	})
+
+	opt := &UpdateMergeRequestOptions{
+		AssigneeIDs: &[]int{},
+		ReviewerIDs: &[]int{2, 3},
+	}
+	mr, _, err := client.MergeRequests.UpdateMergeRequest(1, 5, opt)
+	if err != nil {
+		t.Fatalf("MergeRequests.UpdateMergeRequest returned error: %v", err)
+	}
+
+	want := []*BasicUser{{ID: 2, Username: "alice"}, {ID: 3, Username: "bob"}}
+	if !reflect.DeepEqual(want, mr.Reviewers) {
+		t.Errorf("MergeRequests.UpdateMergeRequest returned reviewers %+v, want %+v", mr.Reviewers, want)
+	}
+	if len(mr.Assignees) != 0 {
+		t.Errorf("MergeRequests.UpdateMergeRequest returned assignees %+v, want none", mr.Assignees)
+	}
+}
+
+func TestGetMergeRequestReviewers(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"user": {"id": 2, "username": "alice"}, "state": "approved"},
+			{"user": {"id": 3, "username": "bob"}, "state": "unreviewed"}
+		]`)
+	})
+
+	reviewers, _, err := client.MergeRequests.GetMergeRequestReviewers(1, 5)
+	if err != nil {
+		t.Fatalf("MergeRequests.GetMergeRequestReviewers returned error: %v", err)
+	}
+
+	want := []*MergeRequestReviewer{
+		{User: &BasicUser{ID: 2, Username: "alice"}, State: ReviewerApproved},
+		{User: &BasicUser{ID: 3, Username: "bob"}, State: ReviewerUnreviewed},
+	}
+	if !reflect.DeepEqual(want, reviewers) {
+		t.Errorf("MergeRequests.GetMergeRequestReviewers returned %+v, want %+v", reviewers, want)
+	}
+}
+
+func TestListProjectMergeRequestsByReviewer(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/merge_requests?reviewer_username=alice&state=opened")
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+
+	opt := &ListProjectMergeRequestsOptions{State: String("opened"), ReviewerUsername: String("alice")}
+	mrs, _, err := client.MergeRequests.ListProjectMergeRequests(1, opt)
+	if err != nil {
+		t.Fatalf("MergeRequests.ListProjectMergeRequests returned error: %v", err)
+	}
+
+	if len(mrs) != 1 || mrs[0].ID != 1 {
+		t.Errorf("MergeRequests.ListProjectMergeRequests returned %+v, want a single merge request", mrs)
+	}
+}
+
+func TestGetMergeRequestParticipants(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/participants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "username": "jdoe", "name": "John Doe", "state": "active", "web_url": "https://gitlab.example.com/jdoe"}]`)
+	})
+
+	participants, _, err := client.MergeRequests.GetMergeRequestParticipants(1, 5)
+	if err != nil {
+		t.Fatalf("MergeRequests.GetMergeRequestParticipants returned error: %v", err)
+	}
+
+	want := []*BasicUser{{ID: 1, Username: "jdoe", Name: "John Doe", State: "active", WebURL: "https://gitlab.example.com/jdoe"}}
+	if !reflect.DeepEqual(want, participants) {
+		t.Errorf("MergeRequests.GetMergeRequestParticipants returned %+v, want %+v", participants, want)
+	}
+}
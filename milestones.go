@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -70,7 +69,7 @@ func (s *MilestonesService) ListMilestones(pid interface{}, opt *ListMilestonesO
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/milestones", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/milestones", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -95,7 +94,7 @@ func (s *MilestonesService) GetMilestone(pid interface{}, milestone int, options
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/milestones/%d", url.QueryEscape(project), milestone)
+	u := fmt.Sprintf("projects/%s/milestones/%d", pathEscape(project), milestone)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -131,7 +130,7 @@ func (s *MilestonesService) CreateMilestone(pid interface{}, opt *CreateMileston
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/milestones", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/milestones", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -168,7 +167,7 @@ func (s *MilestonesService) UpdateMilestone(pid interface{}, milestone int, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/milestones/%d", url.QueryEscape(project), milestone)
+	u := fmt.Sprintf("projects/%s/milestones/%d", pathEscape(project), milestone)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -193,7 +192,7 @@ func (s *MilestonesService) DeleteMilestone(pid interface{}, milestone int, opti
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/milestones/%d", url.QueryEscape(project), milestone)
+	u := fmt.Sprintf("projects/%s/milestones/%d", pathEscape(project), milestone)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -217,7 +216,7 @@ func (s *MilestonesService) GetMilestoneIssues(pid interface{}, milestone int, o
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/milestones/%d/issues", url.QueryEscape(project), milestone)
+	u := fmt.Sprintf("projects/%s/milestones/%d/issues", pathEscape(project), milestone)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -250,7 +249,7 @@ func (s *MilestonesService) GetMilestoneMergeRequests(pid interface{}, milestone
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/milestones/%d/merge_requests", url.QueryEscape(project), milestone)
+	u := fmt.Sprintf("projects/%s/milestones/%d/merge_requests", pathEscape(project), milestone)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
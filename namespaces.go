@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"fmt"
+	"time"
 )
 
 // NamespacesService handles communication with the namespace related methods
@@ -28,17 +29,31 @@ type NamespacesService struct {
 	client *Client
 }
 
-// Namespace represents a GitLab namespace.
+// Namespace represents a GitLab namespace. The plan, seat and storage fields
+// are only set on GitLab.com (and for administrators of instances with a
+// license that enables them).
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/namespaces.html
 type Namespace struct {
-	ID                          int    `json:"id"`
-	Name                        string `json:"name"`
-	Path                        string `json:"path"`
-	Kind                        string `json:"kind"`
-	FullPath                    string `json:"full_path"`
-	ParentID                    int    `json:"parent_id"`
-	MembersCountWithDescendants int    `json:"members_count_with_descendants"`
+	ID                               int        `json:"id"`
+	Name                             string     `json:"name"`
+	Path                             string     `json:"path"`
+	Kind                             string     `json:"kind"`
+	FullPath                         string     `json:"full_path"`
+	ParentID                         int        `json:"parent_id"`
+	MembersCountWithDescendants      int        `json:"members_count_with_descendants"`
+	Plan                             string     `json:"plan"`
+	Trial                            bool       `json:"trial"`
+	TrialEndsOn                      *ISOTime   `json:"trial_ends_on"`
+	EndDate                          *ISOTime   `json:"end_date"`
+	BillableMembersCount             int        `json:"billable_members_count"`
+	SeatsInUse                       int        `json:"seats_in_use"`
+	MaxSeatsUsed                     int        `json:"max_seats_used"`
+	MaxSeatsUsedChangedAt            *time.Time `json:"max_seats_used_changed_at"`
+	ProjectsCount                    int        `json:"projects_count"`
+	RootRepositorySize               int64      `json:"root_repository_size"`
+	AdditionalPurchasedStorageSize   int64      `json:"additional_purchased_storage_size"`
+	AdditionalPurchasedStorageEndsOn *ISOTime   `json:"additional_purchased_storage_ends_on"`
 }
 
 func (n Namespace) String() string {
@@ -105,7 +120,7 @@ func (s *NamespacesService) GetNamespace(id interface{}, options ...OptionFunc)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("namespaces/%s", namespace)
+	u := fmt.Sprintf("namespaces/%s", pathEscape(namespace))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -0,0 +1,48 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetNamespaceSeatUsage(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/namespaces/my-group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 2,
+			"name": "My Group",
+			"kind": "group",
+			"plan": "premium",
+			"billable_members_count": 12,
+			"seats_in_use": 12,
+			"max_seats_used": 15,
+			"root_repository_size": 100,
+			"additional_purchased_storage_size": 10240
+		}`)
+	})
+
+	n, _, err := client.Namespaces.GetNamespace("my-group")
+	if err != nil {
+		t.Fatalf("Namespaces.GetNamespace returned error: %v", err)
+	}
+
+	want := &Namespace{
+		ID:                             2,
+		Name:                           "My Group",
+		Kind:                           "group",
+		Plan:                           "premium",
+		BillableMembersCount:           12,
+		SeatsInUse:                     12,
+		MaxSeatsUsed:                   15,
+		RootRepositorySize:             100,
+		AdditionalPurchasedStorageSize: 10240,
+	}
+	if !reflect.DeepEqual(want, n) {
+		t.Errorf("Namespaces.GetNamespace returned %+v, want %+v", n, want)
+	}
+}
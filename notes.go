@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -66,7 +65,8 @@ type Note struct {
 		AvatarURL string `json:"avatar_url"`
 		WebURL    string `json:"web_url"`
 	} `json:"resolved_by"`
-	NoteableIID int `json:"noteable_iid"`
+	NoteableIID int  `json:"noteable_iid"`
+	Internal    bool `json:"internal"`
 }
 
 // NotePosition represents the position attributes of a note.
@@ -108,7 +108,7 @@ func (s *NotesService) ListIssueNotes(pid interface{}, issue int, opt *ListIssue
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/notes", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d/notes", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -133,7 +133,7 @@ func (s *NotesService) GetIssueNote(pid interface{}, issue, note int, options ..
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/notes/%d", url.QueryEscape(project), issue, note)
+	u := fmt.Sprintf("projects/%s/issues/%d/notes/%d", pathEscape(project), issue, note)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -155,10 +155,12 @@ func (s *NotesService) GetIssueNote(pid interface{}, issue, note int, options ..
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/notes.html#create-new-issue-note
 type CreateIssueNoteOptions struct {
-	Body *string `url:"body,omitempty" json:"body,omitempty"`
+	Body     *string `url:"body,omitempty" json:"body,omitempty"`
+	Internal *bool   `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
-// CreateIssueNote creates a new note to a single project issue.
+// CreateIssueNote creates a new note to a single project issue. Internal
+// notes are only visible to project members with at least the Reporter role.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/notes.html#create-new-issue-note
@@ -167,7 +169,7 @@ func (s *NotesService) CreateIssueNote(pid interface{}, issue int, opt *CreateIs
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/notes", url.QueryEscape(project), issue)
+	u := fmt.Sprintf("projects/%s/issues/%d/notes", pathEscape(project), issue)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -200,7 +202,7 @@ func (s *NotesService) UpdateIssueNote(pid interface{}, issue, note int, opt *Up
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/notes/%d", url.QueryEscape(project), issue, note)
+	u := fmt.Sprintf("projects/%s/issues/%d/notes/%d", pathEscape(project), issue, note)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -224,7 +226,7 @@ func (s *NotesService) DeleteIssueNote(pid interface{}, issue, note int, options
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/issues/%d/notes/%d", url.QueryEscape(project), issue, note)
+	u := fmt.Sprintf("projects/%s/issues/%d/notes/%d", pathEscape(project), issue, note)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -254,7 +256,7 @@ func (s *NotesService) ListSnippetNotes(pid interface{}, snippet int, opt *ListS
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/snippets/%d/notes", url.QueryEscape(project), snippet)
+	u := fmt.Sprintf("projects/%s/snippets/%d/notes", pathEscape(project), snippet)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -279,7 +281,7 @@ func (s *NotesService) GetSnippetNote(pid interface{}, snippet, note int, option
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/snippets/%d/notes/%d", url.QueryEscape(project), snippet, note)
+	u := fmt.Sprintf("projects/%s/snippets/%d/notes/%d", pathEscape(project), snippet, note)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -314,7 +316,7 @@ func (s *NotesService) CreateSnippetNote(pid interface{}, snippet int, opt *Crea
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/snippets/%d/notes", url.QueryEscape(project), snippet)
+	u := fmt.Sprintf("projects/%s/snippets/%d/notes", pathEscape(project), snippet)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -347,7 +349,7 @@ func (s *NotesService) UpdateSnippetNote(pid interface{}, snippet, note int, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/snippets/%d/notes/%d", url.QueryEscape(project), snippet, note)
+	u := fmt.Sprintf("projects/%s/snippets/%d/notes/%d", pathEscape(project), snippet, note)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -371,7 +373,7 @@ func (s *NotesService) DeleteSnippetNote(pid interface{}, snippet, note int, opt
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/snippets/%d/notes/%d", url.QueryEscape(project), snippet, note)
+	u := fmt.Sprintf("projects/%s/snippets/%d/notes/%d", pathEscape(project), snippet, note)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -401,7 +403,7 @@ func (s *NotesService) ListMergeRequestNotes(pid interface{}, mergeRequest int,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_requests/%d/notes", url.QueryEscape(project), mergeRequest)
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/notes", pathEscape(project), mergeRequest)
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -426,7 +428,7 @@ func (s *NotesService) GetMergeRequestNote(pid interface{}, mergeRequest, note i
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_requests/%d/notes/%d", url.QueryEscape(project), mergeRequest, note)
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/notes/%d", pathEscape(project), mergeRequest, note)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -460,7 +462,7 @@ func (s *NotesService) CreateMergeRequestNote(pid interface{}, mergeRequest int,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_requests/%d/notes", url.QueryEscape(project), mergeRequest)
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/notes", pathEscape(project), mergeRequest)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -494,7 +496,7 @@ func (s *NotesService) UpdateMergeRequestNote(pid interface{}, mergeRequest, not
 		return nil, nil, err
 	}
 	u := fmt.Sprintf(
-		"projects/%s/merge_requests/%d/notes/%d", url.QueryEscape(project), mergeRequest, note)
+		"projects/%s/merge_requests/%d/notes/%d", pathEscape(project), mergeRequest, note)
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
 		return nil, nil, err
@@ -518,7 +520,216 @@ func (s *NotesService) DeleteMergeRequestNote(pid interface{}, mergeRequest, not
 		return nil, err
 	}
 	u := fmt.Sprintf(
-		"projects/%s/merge_requests/%d/notes/%d", url.QueryEscape(project), mergeRequest, note)
+		"projects/%s/merge_requests/%d/notes/%d", pathEscape(project), mergeRequest, note)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListEpicNotesOptions represents the available ListEpicNotes() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#list-all-epic-notes
+type ListEpicNotesOptions struct {
+	ListOptions
+	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListEpicNotes gets a list of all notes for a single epic. Epic notes are
+// addressed using the ID of the epic, not its IID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#list-all-epic-notes
+func (s *NotesService) ListEpicNotes(gid interface{}, epic int, opt *ListEpicNotesOptions, options ...OptionFunc) ([]*Note, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes", pathEscape(group), epic)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var n []*Note
+	resp, err := s.client.Do(req, &n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// GetEpicNote returns a single note for an epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#get-single-epic-note
+func (s *NotesService) GetEpicNote(gid interface{}, epic, note int, options ...OptionFunc) (*Note, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes/%d", pathEscape(group), epic, note)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(Note)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// CreateEpicNoteOptions represents the available CreateEpicNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#create-new-epic-note
+type CreateEpicNoteOptions struct {
+	Body     *string `url:"body,omitempty" json:"body,omitempty"`
+	Internal *bool   `url:"internal,omitempty" json:"internal,omitempty"`
+}
+
+// CreateEpicNote creates a new note for a single epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#create-new-epic-note
+func (s *NotesService) CreateEpicNote(gid interface{}, epic int, opt *CreateEpicNoteOptions, options ...OptionFunc) (*Note, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes", pathEscape(group), epic)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(Note)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// UpdateEpicNoteOptions represents the available UpdateEpicNote() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/notes.html#modify-existing-epic-note
+type UpdateEpicNoteOptions struct {
+	Body *string `url:"body,omitempty" json:"body,omitempty"`
+}
+
+// UpdateEpicNote modifies existing note of an epic.
+//
+// https://docs.gitlab.com/ee/api/notes.html#modify-existing-epic-note
+func (s *NotesService) UpdateEpicNote(gid interface{}, epic, note int, opt *UpdateEpicNoteOptions, options ...OptionFunc) (*Note, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes/%d", pathEscape(group), epic, note)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(Note)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// DeleteEpicNote deletes an existing note of an epic.
+//
+// https://docs.gitlab.com/ee/api/notes.html#delete-an-epic-note
+func (s *NotesService) DeleteEpicNote(gid interface{}, epic, note int, options ...OptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes/%d", pathEscape(group), epic, note)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// NoteableTypeValue represents the type of the object a note belongs to, as
+// reported by the noteable_type field of a note.
+type NoteableTypeValue string
+
+// These constants represent the noteable types supported by UpdateNote() and
+// DeleteNote().
+const (
+	IssueNoteable        NoteableTypeValue = "Issue"
+	MergeRequestNoteable NoteableTypeValue = "MergeRequest"
+	SnippetNoteable      NoteableTypeValue = "Snippet"
+	EpicNoteable         NoteableTypeValue = "Epic"
+)
+
+// UpdateNoteOptions represents the available UpdateNote() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/notes.html
+type UpdateNoteOptions struct {
+	Body *string `url:"body,omitempty" json:"body,omitempty"`
+}
+
+// UpdateNote modifies an existing note of any noteable type. The id is the
+// ID of the group for epic notes and of the project for all other notes. The
+// noteable is the IID of issues and merge requests, and the ID of snippets
+// and epics.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/notes.html
+func (s *NotesService) UpdateNote(id interface{}, noteableType NoteableTypeValue, noteable, note int, opt *UpdateNoteOptions, options ...OptionFunc) (*Note, *Response, error) {
+	u, err := noteURL(id, noteableType, noteable, note)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(Note)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, err
+}
+
+// DeleteNote deletes an existing note of any noteable type. See UpdateNote()
+// for the meaning of the id and noteable arguments.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/notes.html
+func (s *NotesService) DeleteNote(id interface{}, noteableType NoteableTypeValue, noteable, note int, options ...OptionFunc) (*Response, error) {
+	u, err := noteURL(id, noteableType, noteable, note)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -527,3 +738,23 @@ func (s *NotesService) DeleteMergeRequestNote(pid interface{}, mergeRequest, not
 
 	return s.client.Do(req, nil)
 }
+
+// noteURL returns the URL of a note of the given noteable type.
+func noteURL(id interface{}, noteableType NoteableTypeValue, noteable, note int) (string, error) {
+	parent, err := parseID(id)
+	if err != nil {
+		return "", err
+	}
+
+	switch noteableType {
+	case IssueNoteable:
+		return fmt.Sprintf("projects/%s/issues/%d/notes/%d", pathEscape(parent), noteable, note), nil
+	case MergeRequestNoteable:
+		return fmt.Sprintf("projects/%s/merge_requests/%d/notes/%d", pathEscape(parent), noteable, note), nil
+	case SnippetNoteable:
+		return fmt.Sprintf("projects/%s/snippets/%d/notes/%d", pathEscape(parent), noteable, note), nil
+	case EpicNoteable:
+		return fmt.Sprintf("groups/%s/epics/%d/notes/%d", pathEscape(parent), noteable, note), nil
+	}
+	return "", fmt.Errorf("unsupported noteable type %q", noteableType)
+}
@@ -0,0 +1,120 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCreateEpicNote(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/4329/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"note"}`)
+		fmt.Fprint(w, `{"id": 3, "body": "note", "noteable_id": 4329, "noteable_type": "Epic"}`)
+	})
+
+	note, _, err := client.Notes.CreateEpicNote(1, 4329, &CreateEpicNoteOptions{Body: String("note")})
+	if err != nil {
+		t.Fatalf("Notes.CreateEpicNote returned error: %v", err)
+	}
+
+	want := &Note{ID: 3, Body: "note", NoteableID: 4329, NoteableType: "Epic"}
+	if !reflect.DeepEqual(want, note) {
+		t.Errorf("Notes.CreateEpicNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestListEpicNotes(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/4329/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/epics/4329/notes?sort=asc")
+		fmt.Fprint(w, `[{"id": 3}, {"id": 4}]`)
+	})
+
+	notes, _, err := client.Notes.ListEpicNotes(1, 4329, &ListEpicNotesOptions{Sort: String("asc")})
+	if err != nil {
+		t.Fatalf("Notes.ListEpicNotes returned error: %v", err)
+	}
+
+	want := []*Note{{ID: 3}, {ID: 4}}
+	if !reflect.DeepEqual(want, notes) {
+		t.Errorf("Notes.ListEpicNotes returned %+v, want %+v", notes, want)
+	}
+}
+
+func TestUpdateNote(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	paths := map[NoteableTypeValue]string{
+		IssueNoteable:        "/api/v4/projects/1/issues/2/notes/3",
+		MergeRequestNoteable: "/api/v4/projects/1/merge_requests/2/notes/3",
+		SnippetNoteable:      "/api/v4/projects/1/snippets/2/notes/3",
+		EpicNoteable:         "/api/v4/groups/1/epics/2/notes/3",
+	}
+	for _, path := range paths {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			testBody(t, r, `{"body":"[removed]"}`)
+			fmt.Fprint(w, `{"id": 3, "body": "[removed]"}`)
+		})
+	}
+
+	for typ := range paths {
+		note, _, err := client.Notes.UpdateNote(1, typ, 2, 3, &UpdateNoteOptions{Body: String("[removed]")})
+		if err != nil {
+			t.Fatalf("Notes.UpdateNote returned error for %s: %v", typ, err)
+		}
+
+		want := &Note{ID: 3, Body: "[removed]"}
+		if !reflect.DeepEqual(want, note) {
+			t.Errorf("Notes.UpdateNote returned %+v for %s, want %+v", note, typ, want)
+		}
+	}
+
+	if _, _, err := client.Notes.UpdateNote(1, "Commit", 2, 3, nil); err == nil {
+		t.Errorf("Notes.UpdateNote returned no error for an unsupported noteable type")
+	}
+}
+
+func TestDeleteNote(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/mygroup/epics/2/notes/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	if _, err := client.Notes.DeleteNote("mygroup", EpicNoteable, 2, 3); err != nil {
+		t.Fatalf("Notes.DeleteNote returned error: %v", err)
+	}
+}
+
+func TestCreateInternalIssueNote(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/2/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"restricted","internal":true}`)
+		fmt.Fprint(w, `{"id": 3, "body": "restricted", "internal": true}`)
+	})
+
+	opt := &CreateIssueNoteOptions{Body: String("restricted"), Internal: Bool(true)}
+	note, _, err := client.Notes.CreateIssueNote(1, 2, opt)
+	if err != nil {
+		t.Fatalf("Notes.CreateIssueNote returned error: %v", err)
+	}
+
+	want := &Note{ID: 3, Body: "restricted", Internal: true}
+	if !reflect.DeepEqual(want, note) {
+		t.Errorf("Notes.CreateIssueNote returned %+v, want %+v", note, want)
+	}
+}
@@ -2,7 +2,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -45,7 +44,7 @@ func (s *PagesDomainsService) ListPagesDomains(pid interface{}, opt *ListPagesDo
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/pages/domains", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -89,7 +88,7 @@ func (s *PagesDomainsService) GetPagesDomain(pid interface{}, domain string, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains/%s", url.QueryEscape(project), domain)
+	u := fmt.Sprintf("projects/%s/pages/domains/%s", pathEscape(project), domain)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -111,7 +110,7 @@ func (s *PagesDomainsService) GetPagesDomain(pid interface{}, domain string, opt
 // // https://docs.gitlab.com/ce/api/pages_domains.html#create-new-pages-domain
 type CreatePagesDomainOptions struct {
 	Domain      *string `url:"domain,omitempty" json:"domain,omitempty"`
-	Certificate *string `url:"certifiate,omitempty" json:"certifiate,omitempty"`
+	Certificate *string `url:"certificate,omitempty" json:"certificate,omitempty"`
 	Key         *string `url:"key,omitempty" json:"key,omitempty"`
 }
 
@@ -124,7 +123,7 @@ func (s *PagesDomainsService) CreatePagesDomain(pid interface{}, opt *CreatePage
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/pages/domains", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -145,8 +144,8 @@ func (s *PagesDomainsService) CreatePagesDomain(pid interface{}, opt *CreatePage
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pages_domains.html#update-pages-domain
 type UpdatePagesDomainOptions struct {
-	Cerificate *string `url:"certifiate" json:"certifiate"`
-	Key        *string `url:"key" json:"key"`
+	Cerificate *string `url:"certificate,omitempty" json:"certificate,omitempty"`
+	Key        *string `url:"key,omitempty" json:"key,omitempty"`
 }
 
 // UpdatePagesDomain updates an existing project pages domain.
@@ -158,7 +157,7 @@ func (s *PagesDomainsService) UpdatePagesDomain(pid interface{}, domain string,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains/%s", url.QueryEscape(project), domain)
+	u := fmt.Sprintf("projects/%s/pages/domains/%s", pathEscape(project), domain)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -183,7 +182,7 @@ func (s *PagesDomainsService) DeletePagesDomain(pid interface{}, domain string,
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains/%s", url.QueryEscape(project), domain)
+	u := fmt.Sprintf("projects/%s/pages/domains/%s", pathEscape(project), domain)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
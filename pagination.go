@@ -0,0 +1,43 @@
+package gitlab
+
+import "errors"
+
+// Paginate calls fn for every page of a paginated list, until there are no
+// more pages or fn returns an error. fn must request the page set in opt,
+// which are the list options of the list method called by fn, and return
+// the response. The pagination starts at the page set in opt, the first page
+// by default. Keyset pagination is not supported and returns an error without
+// calling fn; follow Response.NextLink with WithKeysetPaginationParameters
+// instead.
+//
+// Example usage:
+//
+//	opt := &gitlab.ListProjectsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+//	var projects []*gitlab.Project
+//	err := gitlab.Paginate(&opt.ListOptions, func() (*gitlab.Response, error) {
+//		ps, resp, err := git.Projects.ListProjects(opt)
+//		projects = append(projects, ps...)
+//		return resp, err
+//	})
+func Paginate(opt *ListOptions, fn func() (*Response, error)) error {
+	// The page number of a keyset paginated list never advances, so only
+	// the first page would be returned.
+	if opt.Pagination == "keyset" {
+		return errors.New("gitlab: Paginate does not support keyset pagination, " +
+			"follow Response.NextLink using WithKeysetPaginationParameters instead")
+	}
+
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+
+		// Stop when the server does not advance, instead of requesting the
+		// same page over and over again.
+		if resp == nil || resp.NextPage == 0 || resp.NextPage == opt.Page {
+			return nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,125 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.URL.Query().Get("page"); page {
+		case "":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"tag_name": "v3"}]`)
+		case "2":
+			// Only the Link header is set, like for large offset paginated lists.
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v4/projects/1/releases?page=3&per_page=1>; rel="next", <%s/api/v4/projects/1/releases?page=1&per_page=1>; rel="first"`, server.URL, server.URL))
+			fmt.Fprint(w, `[{"tag_name": "v2"}]`)
+		case "3":
+			fmt.Fprint(w, `[{"tag_name": "v1"}]`)
+		default:
+			t.Errorf("Unexpected page %s", page)
+		}
+	})
+
+	opt := &ListReleasesOptions{ListOptions: ListOptions{PerPage: 1}}
+	var releases []*Release
+	err := Paginate(&opt.ListOptions, func() (*Response, error) {
+		rs, resp, err := client.Releases.ListReleases(1, opt)
+		releases = append(releases, rs...)
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("Paginate returned error: %v", err)
+	}
+
+	want := []*Release{{TagName: "v3"}, {TagName: "v2"}, {TagName: "v1"}}
+	if !reflect.DeepEqual(want, releases) {
+		t.Errorf("Paginate collected %+v, want %+v", releases, want)
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	errStop := errors.New("stop")
+
+	calls := 0
+	err := Paginate(&ListOptions{}, func() (*Response, error) {
+		calls++
+		return &Response{NextPage: 2}, errStop
+	})
+	if err != errStop {
+		t.Errorf("Paginate returned error %v, want %v", err, errStop)
+	}
+	if calls != 1 {
+		t.Errorf("Paginate called fn %d times, want 1", calls)
+	}
+}
+
+func TestPaginateKeyset(t *testing.T) {
+	calls := 0
+	err := Paginate(&ListOptions{Pagination: "keyset"}, func() (*Response, error) {
+		calls++
+		return &Response{}, nil
+	})
+	if err == nil {
+		t.Error("Paginate returned no error for keyset pagination")
+	}
+	if calls != 0 {
+		t.Errorf("Paginate called fn %d times, want 0", calls)
+	}
+}
+
+func TestKeysetPagination(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.RawQuery {
+		case "order_by=id&pagination=keyset&per_page=1&sort=asc":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v4/projects?id_after=1&order_by=id&pagination=keyset&per_page=1&sort=asc>; rel="next"`, server.URL))
+			fmt.Fprint(w, `[{"id": 1}]`)
+		case "id_after=1&order_by=id&pagination=keyset&per_page=1&sort=asc":
+			fmt.Fprint(w, `[{"id": 2}]`)
+		default:
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+	})
+
+	opt := &ListProjectsOptions{
+		ListOptions: ListOptions{PerPage: 1, Pagination: "keyset"},
+		OrderBy:     String("id"),
+		Sort:        String("asc"),
+	}
+
+	var ids []int
+	var options []OptionFunc
+	for {
+		ps, resp, err := client.Projects.ListProjects(opt, options...)
+		if err != nil {
+			t.Fatalf("Projects.ListProjects returned error: %v", err)
+		}
+		for _, p := range ps {
+			ids = append(ids, p.ID)
+		}
+		if resp.NextLink == "" {
+			break
+		}
+		if resp.NextPage != 0 {
+			t.Errorf("NextPage is %d, want 0 for keyset pagination", resp.NextPage)
+		}
+		options = []OptionFunc{WithKeysetPaginationParameters(resp.NextLink)}
+	}
+
+	want := []int{1, 2}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Keyset pagination returned %v, want %v", ids, want)
+	}
+}
@@ -0,0 +1,106 @@
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PersonalAccessTokensService handles communication with the personal access
+// tokens related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/personal_access_tokens.html
+type PersonalAccessTokensService struct {
+	client *Client
+}
+
+// PersonalAccessToken represents a personal access token.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/personal_access_tokens.html
+type PersonalAccessToken struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Revoked    bool       `json:"revoked"`
+	CreatedAt  *time.Time `json:"created_at"`
+	Scopes     []string   `json:"scopes"`
+	UserID     int        `json:"user_id"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Active     bool       `json:"active"`
+	ExpiresAt  *ISOTime   `json:"expires_at"`
+	Token      string     `json:"token"`
+}
+
+func (p PersonalAccessToken) String() string {
+	return Stringify(p)
+}
+
+// MissingScopes returns the scopes out of the given list that are not granted
+// to the token.
+func (p PersonalAccessToken) MissingScopes(scopes ...string) []string {
+	granted := make(map[string]bool, len(p.Scopes))
+	for _, s := range p.Scopes {
+		granted[s] = true
+	}
+
+	var missing []string
+	for _, s := range scopes {
+		if !granted[s] {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+// GetSelf gets the personal access token used to authenticate the request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/personal_access_tokens.html#using-a-request-header
+func (s *PersonalAccessTokensService) GetSelf(options ...OptionFunc) (*PersonalAccessToken, *Response, error) {
+	req, err := s.client.NewRequest("GET", "personal_access_tokens/self", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// RevokeSelf revokes the personal access token used to authenticate the
+// request. The client can not be used with the same token afterwards.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/personal_access_tokens.html#using-a-request-header-1
+func (s *PersonalAccessTokensService) RevokeSelf(options ...OptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "personal_access_tokens/self", nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RequireScopes gets the personal access token used to authenticate the
+// request and returns an error if it is not active, or if any of the given
+// scopes is not granted to it. This allows tools to fail early with a clear
+// message instead of hitting 403 responses halfway through their work.
+func (s *PersonalAccessTokensService) RequireScopes(scopes []string, options ...OptionFunc) (*PersonalAccessToken, *Response, error) {
+	pat, resp, err := s.GetSelf(options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if !pat.Active || pat.Revoked {
+		return pat, resp, fmt.Errorf("personal access token %q is not active", pat.Name)
+	}
+	if missing := pat.MissingScopes(scopes...); len(missing) > 0 {
+		return pat, resp, fmt.Errorf("personal access token %q is missing required scopes: %s",
+			pat.Name, strings.Join(missing, ", "))
+	}
+
+	return pat, resp, nil
+}
@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetSelfPersonalAccessToken(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 4, "name": "sync", "active": true, "scopes": ["read_api"], "user_id": 3, "expires_at": "2019-01-01"}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.GetSelf()
+	if err != nil {
+		t.Fatalf("PersonalAccessTokens.GetSelf returned error: %v", err)
+	}
+
+	if pat.ID != 4 || pat.Name != "sync" || !pat.Active || pat.ExpiresAt.String() != "2019-01-01" {
+		t.Errorf("PersonalAccessTokens.GetSelf returned %+v", pat)
+	}
+}
+
+func TestRequireScopes(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 4, "name": "sync", "active": true, "scopes": ["read_api"]}`)
+	})
+
+	if _, _, err := client.PersonalAccessTokens.RequireScopes([]string{"read_api"}); err != nil {
+		t.Errorf("PersonalAccessTokens.RequireScopes returned error: %v", err)
+	}
+
+	_, _, err := client.PersonalAccessTokens.RequireScopes([]string{"read_api", "api", "write_repository"})
+	want := `personal access token "sync" is missing required scopes: api, write_repository`
+	if err == nil || err.Error() != want {
+		t.Errorf("PersonalAccessTokens.RequireScopes returned error %v, want %q", err, want)
+	}
+}
+
+func TestRevokeSelfPersonalAccessToken(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.PersonalAccessTokens.RevokeSelf(); err != nil {
+		t.Errorf("PersonalAccessTokens.RevokeSelf returned error: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCreatePipelineScheduleVariable(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/2/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"CONFIG","value":"debug: true","variable_type":"file"}`)
+		fmt.Fprint(w, `{"key": "CONFIG", "value": "debug: true", "variable_type": "file"}`)
+	})
+
+	opt := &CreatePipelineScheduleVariableOptions{
+		Key:          String("CONFIG"),
+		Value:        String("debug: true"),
+		VariableType: VariableType(FileVariableType),
+	}
+	variable, _, err := client.PipelineSchedules.CreatePipelineScheduleVariable(1, 2, opt)
+	if err != nil {
+		t.Fatalf("PipelineSchedules.CreatePipelineScheduleVariable returned error: %v", err)
+	}
+
+	want := &PipelineVariable{Key: "CONFIG", Value: "debug: true", VariableType: FileVariableType}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("PipelineSchedules.CreatePipelineScheduleVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestRunPipelineSchedule(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/2/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"message": "201 Created"}`)
+	})
+
+	run, _, err := client.PipelineSchedules.RunPipelineSchedule(1, 2)
+	if err != nil {
+		t.Fatalf("PipelineSchedules.RunPipelineSchedule returned error: %v", err)
+	}
+
+	want := &RunPipelineScheduleResponse{Message: "201 Created"}
+	if !reflect.DeepEqual(want, run) {
+		t.Errorf("PipelineSchedules.RunPipelineSchedule returned %+v, want %+v", run, want)
+	}
+}
+
+func TestListPipelinesTriggeredBySchedule(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/2/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/pipeline_schedules/2/pipelines?status=running")
+		fmt.Fprint(w, `[{"id": 48, "ref": "main", "sha": "b0b3a907", "status": "running", "web_url": "https://gitlab.example.com/p/-/pipelines/48"}]`)
+	})
+
+	opt := &ListPipelinesTriggeredByScheduleOptions{Status: BuildState(Running)}
+	pipelines, _, err := client.PipelineSchedules.ListPipelinesTriggeredBySchedule(1, 2, opt)
+	if err != nil {
+		t.Fatalf("PipelineSchedules.ListPipelinesTriggeredBySchedule returned error: %v", err)
+	}
+
+	want := PipelineList{{ID: 48, Ref: "main", SHA: "b0b3a907", Status: "running", WebURL: "https://gitlab.example.com/p/-/pipelines/48"}}
+	if !reflect.DeepEqual(want, pipelines) {
+		t.Errorf("PipelineSchedules.ListPipelinesTriggeredBySchedule returned %+v, want %+v", pipelines, want)
+	}
+}
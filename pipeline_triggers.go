@@ -2,7 +2,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -44,7 +43,7 @@ func (s *PipelineTriggersService) ListPipelineTriggers(pid interface{}, opt *Lis
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/triggers", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/triggers", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -69,7 +68,7 @@ func (s *PipelineTriggersService) GetPipelineTrigger(pid interface{}, trigger in
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/triggers/%d", url.QueryEscape(project), trigger)
+	u := fmt.Sprintf("projects/%s/triggers/%d", pathEscape(project), trigger)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -102,7 +101,7 @@ func (s *PipelineTriggersService) AddPipelineTrigger(pid interface{}, opt *AddPi
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/triggers", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/triggers", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -135,7 +134,7 @@ func (s *PipelineTriggersService) EditPipelineTrigger(pid interface{}, trigger i
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/triggers/%d", url.QueryEscape(project), trigger)
+	u := fmt.Sprintf("projects/%s/triggers/%d", pathEscape(project), trigger)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -161,7 +160,7 @@ func (s *PipelineTriggersService) TakeOwnershipOfPipelineTrigger(pid interface{}
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/triggers/%d/take_ownership", url.QueryEscape(project), trigger)
+	u := fmt.Sprintf("projects/%s/triggers/%d/take_ownership", pathEscape(project), trigger)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -186,7 +185,7 @@ func (s *PipelineTriggersService) DeletePipelineTrigger(pid interface{}, trigger
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/triggers/%d", url.QueryEscape(project), trigger)
+	u := fmt.Sprintf("projects/%s/triggers/%d", pathEscape(project), trigger)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -201,8 +200,8 @@ func (s *PipelineTriggersService) DeletePipelineTrigger(pid interface{}, trigger
 // GitLab API docs:
 // https://docs.gitlab.com/ce/ci/triggers/README.html#triggering-a-pipeline
 type RunPipelineTriggerOptions struct {
-	Ref       *string           `url:"ref" json:"ref"`
-	Token     *string           `url:"token" json:"token"`
+	Ref       *string           `url:"ref,omitempty" json:"ref,omitempty"`
+	Token     *string           `url:"token,omitempty" json:"token,omitempty"`
 	Variables map[string]string `url:"variables,omitempty" json:"variables,omitempty"`
 }
 
@@ -215,7 +214,7 @@ func (s *PipelineTriggersService) RunPipelineTrigger(pid interface{}, opt *RunPi
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/trigger/pipeline", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/trigger/pipeline", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -17,8 +17,8 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
-	"net/url"
 	"time"
 )
 
@@ -34,8 +34,9 @@ type PipelinesService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html
 type PipelineVariable struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key          string            `json:"key"`
+	Value        string            `json:"value"`
+	VariableType VariableTypeValue `json:"variable_type,omitempty"`
 }
 
 // Pipeline represents a GitLab pipeline.
@@ -43,6 +44,7 @@ type PipelineVariable struct {
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html
 type Pipeline struct {
 	ID         int    `json:"id"`
+	Name       string `json:"name"`
 	Status     string `json:"status"`
 	Ref        string `json:"ref"`
 	SHA        string `json:"sha"`
@@ -70,16 +72,27 @@ func (i Pipeline) String() string {
 	return Stringify(i)
 }
 
-// PipelineList represents a GitLab list project pipelines
+// PipelineInfo shows the basic entities of a pipeline, mostly used as fields
+// on other assets, like Commit.
 //
-// GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#list-project-pipelines
-type PipelineList []struct {
+// GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html
+type PipelineInfo struct {
 	ID     int    `json:"id"`
 	Status string `json:"status"`
 	Ref    string `json:"ref"`
 	SHA    string `json:"sha"`
+	WebURL string `json:"web_url"`
+}
+
+func (p PipelineInfo) String() string {
+	return Stringify(p)
 }
 
+// PipelineList represents a GitLab list project pipelines
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#list-project-pipelines
+type PipelineList []PipelineInfo
+
 func (i PipelineList) String() string {
 	return Stringify(i)
 }
@@ -108,7 +121,7 @@ func (s *PipelinesService) ListProjectPipelines(pid interface{}, opt *ListProjec
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipelines", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/pipelines", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -131,7 +144,7 @@ func (s *PipelinesService) GetPipeline(pid interface{}, pipeline int, options ..
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipelines/%d", url.QueryEscape(project), pipeline)
+	u := fmt.Sprintf("projects/%s/pipelines/%d", pathEscape(project), pipeline)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -147,11 +160,36 @@ func (s *PipelinesService) GetPipeline(pid interface{}, pipeline int, options ..
 	return p, resp, err
 }
 
+// GetPipelineVariables gets the variables of a single project pipeline.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/pipelines.html#get-variables-of-a-pipeline
+func (s *PipelinesService) GetPipelineVariables(pid interface{}, pipeline int, options ...OptionFunc) ([]*PipelineVariable, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pipelines/%d/variables", pathEscape(project), pipeline)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var vs []*PipelineVariable
+	resp, err := s.client.Do(req, &vs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return vs, resp, err
+}
+
 // CreatePipelineOptions represents the available CreatePipeline() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#create-a-new-pipeline
 type CreatePipelineOptions struct {
-	Ref       *string             `url:"ref" json:"ref"`
+	Ref       *string             `url:"ref,omitempty" json:"ref,omitempty"`
 	Variables []*PipelineVariable `url:"variables,omitempty" json:"variables,omitempty"`
 }
 
@@ -163,7 +201,7 @@ func (s *PipelinesService) CreatePipeline(pid interface{}, opt *CreatePipelineOp
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipeline", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/pipeline", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -179,6 +217,41 @@ func (s *PipelinesService) CreatePipeline(pid interface{}, opt *CreatePipelineOp
 	return p, resp, err
 }
 
+// UpdatePipelineMetadataOptions represents the available
+// UpdatePipelineMetadata() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/pipelines.html#update-pipeline-metadata
+type UpdatePipelineMetadataOptions struct {
+	Name *string `url:"name,omitempty" json:"name,omitempty"`
+}
+
+// UpdatePipelineMetadata updates the metadata of a pipeline, such as the name
+// shown in the pipeline list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/pipelines.html#update-pipeline-metadata
+func (s *PipelinesService) UpdatePipelineMetadata(pid interface{}, pipeline int, opt *UpdatePipelineMetadataOptions, options ...OptionFunc) (*Pipeline, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/pipelines/%d/metadata", pathEscape(project), pipeline)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(Pipeline)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
 // RetryPipelineBuild retries failed builds in a pipeline
 //
 // GitLab API docs:
@@ -188,7 +261,7 @@ func (s *PipelinesService) RetryPipelineBuild(pid interface{}, pipelineID int, o
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipelines/%d/retry", project, pipelineID)
+	u := fmt.Sprintf("projects/%s/pipelines/%d/retry", pathEscape(project), pipelineID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -213,7 +286,7 @@ func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipelineID int,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipelines/%d/cancel", project, pipelineID)
+	u := fmt.Sprintf("projects/%s/pipelines/%d/cancel", pathEscape(project), pipelineID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -228,3 +301,47 @@ func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipelineID int,
 
 	return p, resp, err
 }
+
+// WaitForPipelineOptions represents the available WaitForPipeline() options.
+type WaitForPipelineOptions struct {
+	// Interval is the time to wait before polling the pipeline status for
+	// the second time. Defaults to 5 seconds.
+	Interval time.Duration
+
+	// MaxInterval is the maximum time to wait between two polls, as the
+	// interval is doubled after each poll. Defaults to 1 minute.
+	MaxInterval time.Duration
+}
+
+// WaitForPipeline polls the status of a pipeline until it reaches a terminal
+// state (success, failed, canceled or skipped) and returns the final pipeline.
+// Use ctx to limit the total time to wait.
+func (s *PipelinesService) WaitForPipeline(ctx context.Context, pid interface{}, pipeline int, opt *WaitForPipelineOptions, options ...OptionFunc) (*Pipeline, *Response, error) {
+	interval, maxInterval := 5*time.Second, time.Minute
+	if opt != nil && opt.Interval > 0 {
+		interval = opt.Interval
+	}
+	if opt != nil && opt.MaxInterval > 0 {
+		maxInterval = opt.MaxInterval
+	}
+
+	var p *Pipeline
+	var resp *Response
+
+	err := PollUntil(ctx, interval, func(ctx context.Context) (bool, error) {
+		var err error
+		p, resp, err = s.GetPipeline(pid, pipeline, append(options, WithContext(ctx))...)
+		if err != nil {
+			p = nil
+			return false, err
+		}
+
+		switch BuildStateValue(p.Status) {
+		case Success, Failed, Canceled, Skipped:
+			return true, nil
+		}
+		return false, nil
+	}, &PollOptions{MaxInterval: maxInterval})
+
+	return p, resp, err
+}
@@ -1,10 +1,12 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListProjectPipelines(t *testing.T) {
@@ -28,6 +30,28 @@ func TestListProjectPipelines(t *testing.T) {
 	}
 }
 
+func TestListProjectPipelinesBySHA(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/pipelines?sha=b0b3a907")
+		fmt.Fprint(w, `[{"id":1,"ref":"main","sha":"b0b3a907"},{"id":2,"ref":"v1.0","sha":"b0b3a907"}]`)
+	})
+
+	opt := &ListProjectPipelinesOptions{SHA: String("b0b3a907")}
+	piplines, _, err := client.Pipelines.ListProjectPipelines(1, opt)
+	if err != nil {
+		t.Errorf("Pipelines.ListProjectPipelines returned error: %v", err)
+	}
+
+	want := PipelineList{{ID: 1, Ref: "main", SHA: "b0b3a907"}, {ID: 2, Ref: "v1.0", SHA: "b0b3a907"}}
+	if !reflect.DeepEqual(want, piplines) {
+		t.Errorf("Pipelines.ListProjectPipelines returned %+v, want %+v", piplines, want)
+	}
+}
+
 func TestGetPipeline(t *testing.T) {
 	mux, server, client := setup()
 	defer teardown(server)
@@ -70,6 +94,74 @@ func TestCreatePipeline(t *testing.T) {
 	}
 }
 
+func TestCreatePipelineWithVariables(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"master","variables":[{"key":"DEPLOY","value":"true"},{"key":"CONFIG","value":"a: b","variable_type":"file"}]}`)
+		fmt.Fprint(w, `{"id":1, "status":"pending"}`)
+	})
+
+	opt := &CreatePipelineOptions{
+		Ref: String("master"),
+		Variables: []*PipelineVariable{
+			{Key: "DEPLOY", Value: "true"},
+			{Key: "CONFIG", Value: "a: b", VariableType: FileVariableType},
+		},
+	}
+	_, _, err := client.Pipelines.CreatePipeline(1, opt)
+	if err != nil {
+		t.Errorf("Pipelines.CreatePipeline returned error: %v", err)
+	}
+}
+
+func TestGetPipelineVariables(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/5/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"key":"RUN_NIGHTLY_BUILD","variable_type":"env_var","value":"true"},{"key":"foo","value":"bar","variable_type":"file"}]`)
+	})
+
+	variables, _, err := client.Pipelines.GetPipelineVariables(1, 5)
+	if err != nil {
+		t.Errorf("Pipelines.GetPipelineVariables returned error: %v", err)
+	}
+
+	want := []*PipelineVariable{
+		{Key: "RUN_NIGHTLY_BUILD", Value: "true", VariableType: EnvVariableType},
+		{Key: "foo", Value: "bar", VariableType: FileVariableType},
+	}
+	if !reflect.DeepEqual(want, variables) {
+		t.Errorf("Pipelines.GetPipelineVariables returned %+v, want %+v", variables, want)
+	}
+}
+
+func TestUpdatePipelineMetadata(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines/5/metadata", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"Nightly build"}`)
+		fmt.Fprint(w, `{"id":5, "name":"Nightly build", "status":"running"}`)
+	})
+
+	opt := &UpdatePipelineMetadataOptions{Name: String("Nightly build")}
+	pipeline, _, err := client.Pipelines.UpdatePipelineMetadata(1, 5, opt)
+	if err != nil {
+		t.Errorf("Pipelines.UpdatePipelineMetadata returned error: %v", err)
+	}
+
+	want := &Pipeline{ID: 5, Name: "Nightly build", Status: "running"}
+	if !reflect.DeepEqual(want, pipeline) {
+		t.Errorf("Pipelines.UpdatePipelineMetadata returned %+v, want %+v", pipeline, want)
+	}
+}
+
 func TestRetryPipelineBuild(t *testing.T) {
 	mux, server, client := setup()
 	defer teardown(server)
@@ -90,6 +182,33 @@ func TestRetryPipelineBuild(t *testing.T) {
 	}
 }
 
+func TestRetryAndCancelPipelineBuildNamespacedPath(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var paths []string
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		paths = append(paths, r.URL.EscapedPath())
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	if _, _, err := client.Pipelines.RetryPipelineBuild("group/project", 5949167); err != nil {
+		t.Fatalf("Pipelines.RetryPipelineBuild returned error: %v", err)
+	}
+	if _, _, err := client.Pipelines.CancelPipelineBuild("group/project", 5949167); err != nil {
+		t.Fatalf("Pipelines.CancelPipelineBuild returned error: %v", err)
+	}
+
+	want := []string{
+		"/api/v4/projects/group%2Fproject/pipelines/5949167/retry",
+		"/api/v4/projects/group%2Fproject/pipelines/5949167/cancel",
+	}
+	if !reflect.DeepEqual(want, paths) {
+		t.Errorf("Requests were sent to %q, want %q", paths, want)
+	}
+}
+
 func TestCancelPipelineBuild(t *testing.T) {
 	mux, server, client := setup()
 	defer teardown(server)
@@ -109,3 +228,33 @@ func TestCancelPipelineBuild(t *testing.T) {
 		t.Errorf("Pipelines.CancelPipelineBuild returned %+v, want %+v", pipeline, want)
 	}
 }
+
+func TestWaitForPipeline(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var calls int
+	mux.HandleFunc("/api/v4/projects/1/pipelines/5949167", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			fmt.Fprint(w, `{"id":1,"status":"running"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"status":"failed"}`)
+	})
+
+	opt := &WaitForPipelineOptions{Interval: time.Millisecond}
+	pipeline, _, err := client.Pipelines.WaitForPipeline(context.Background(), 1, 5949167, opt)
+	if err != nil {
+		t.Errorf("Pipelines.WaitForPipeline returned error: %v", err)
+	}
+
+	want := &Pipeline{ID: 1, Status: "failed"}
+	if !reflect.DeepEqual(want, pipeline) {
+		t.Errorf("Pipelines.WaitForPipeline returned %+v, want %+v", pipeline, want)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
@@ -0,0 +1,72 @@
+package gitlab
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// PollOptions represents the available PollUntil() options.
+type PollOptions struct {
+	// MaxInterval makes the interval double after every poll, until it
+	// reaches MaxInterval. The interval is constant if zero.
+	MaxInterval time.Duration
+
+	// Jitter randomly spreads every interval by up to the given fraction of
+	// the interval (e.g. 0.1 for 10%), so many pollers started at the same
+	// time don't keep hitting the server at the same time.
+	Jitter float64
+
+	// MaxDuration is the maximum total time to poll, after which
+	// context.DeadlineExceeded is returned. No limit is applied if zero.
+	MaxDuration time.Duration
+}
+
+// PollUntil calls check every interval until it reports done or returns an
+// error, or until ctx is done. This is useful for asynchronous operations
+// that respond with 202 Accepted and have to be polled for completion.
+//
+// Example usage:
+//
+//	err := gitlab.PollUntil(ctx, 5*time.Second, func(ctx context.Context) (bool, error) {
+//		p, _, err := git.Pipelines.GetPipeline(pid, id, gitlab.WithContext(ctx))
+//		if err != nil {
+//			return false, err
+//		}
+//		return p.Status == "success", nil
+//	}, &gitlab.PollOptions{MaxDuration: time.Hour})
+func PollUntil(ctx context.Context, interval time.Duration, check func(context.Context) (bool, error), opt *PollOptions) error {
+	if opt == nil {
+		opt = new(PollOptions)
+	}
+	if opt.MaxDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opt.MaxDuration)
+		defer cancel()
+	}
+
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+
+		if err := sleepContext(ctx, jitter(interval, opt.Jitter)); err != nil {
+			return err
+		}
+
+		if opt.MaxInterval > interval {
+			if interval *= 2; interval > opt.MaxInterval {
+				interval = opt.MaxInterval
+			}
+		}
+	}
+}
+
+// jitter randomly adds or subtracts up to the given fraction of d.
+func jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return d
+	}
+	return d + time.Duration((rand.Float64()*2-1)*fraction*float64(d))
+}
@@ -0,0 +1,52 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPollUntil(t *testing.T) {
+	var calls int
+	err := PollUntil(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	}, &PollOptions{MaxInterval: 4 * time.Millisecond, Jitter: 0.5})
+	if err != nil {
+		t.Fatalf("PollUntil returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestPollUntilError(t *testing.T) {
+	want := errors.New("failed")
+	err := PollUntil(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		return false, want
+	}, nil)
+	if err != want {
+		t.Errorf("PollUntil returned error %v, want %v", err, want)
+	}
+}
+
+func TestPollUntilMaxDuration(t *testing.T) {
+	err := PollUntil(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		return false, nil
+	}, &PollOptions{MaxDuration: 10 * time.Millisecond})
+	if err != context.DeadlineExceeded {
+		t.Errorf("PollUntil returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if d := jitter(time.Second, 0.1); d < 900*time.Millisecond || d > 1100*time.Millisecond {
+			t.Fatalf("jitter returned %v, want a duration between 900ms and 1.1s", d)
+		}
+	}
+	if d := jitter(time.Second, 0); d != time.Second {
+		t.Errorf("jitter returned %v, want %v", d, time.Second)
+	}
+}
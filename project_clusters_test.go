@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectClusters(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"id": 18,
+			"name": "cluster-1",
+			"environment_scope": "*",
+			"platform_kubernetes": {"api_url": "https://104.197.68.152", "namespace": "cluster-1-namespace"}
+		}]`)
+	})
+
+	clusters, _, err := client.ProjectClusters.ListClusters(1)
+	if err != nil {
+		t.Fatalf("ProjectClusters.ListClusters returned error: %v", err)
+	}
+
+	want := []*ProjectCluster{{
+		ID:               18,
+		Name:             "cluster-1",
+		EnvironmentScope: "*",
+		PlatformKubernetes: &PlatformKubernetes{
+			APIURL:    "https://104.197.68.152",
+			Namespace: "cluster-1-namespace",
+		},
+	}}
+	if !reflect.DeepEqual(want, clusters) {
+		t.Errorf("ProjectClusters.ListClusters returned %+v, want %+v", clusters, want)
+	}
+}
+
+func TestAddProjectCluster(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/clusters/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"cluster-5","environment_scope":"*","platform_kubernetes_attributes":{"api_url":"https://35.111.51.20","token":"12345"}}`)
+		fmt.Fprint(w, `{"id": 24, "name": "cluster-5"}`)
+	})
+
+	opt := &AddClusterOptions{
+		Name:             String("cluster-5"),
+		EnvironmentScope: String("*"),
+		PlatformKubernetes: &AddPlatformKubernetesOptions{
+			APIURL: String("https://35.111.51.20"),
+			Token:  String("12345"),
+		},
+	}
+	cluster, _, err := client.ProjectClusters.AddCluster(1, opt)
+	if err != nil {
+		t.Fatalf("ProjectClusters.AddCluster returned error: %v", err)
+	}
+
+	want := &ProjectCluster{ID: 24, Name: "cluster-5"}
+	if !reflect.DeepEqual(want, cluster) {
+		t.Errorf("ProjectClusters.AddCluster returned %+v, want %+v", cluster, want)
+	}
+}
+
+func TestAddInstanceCluster(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/add", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 11, "name": "cluster-3", "cluster_type": "instance_type"}`)
+	})
+
+	cluster, _, err := client.InstanceClusters.AddCluster(&AddInstanceClusterOptions{Name: String("cluster-3")})
+	if err != nil {
+		t.Fatalf("InstanceClusters.AddCluster returned error: %v", err)
+	}
+
+	want := &InstanceCluster{ID: 11, Name: "cluster-3", ClusterType: "instance_type"}
+	if !reflect.DeepEqual(want, cluster) {
+		t.Errorf("InstanceClusters.AddCluster returned %+v, want %+v", cluster, want)
+	}
+}
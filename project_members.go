@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // ProjectMembersService handles communication with the project members
@@ -50,7 +49,7 @@ func (s *ProjectMembersService) ListProjectMembers(pid interface{}, opt *ListPro
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/members", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/members", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -77,7 +76,7 @@ func (s *ProjectMembersService) ListAllProjectMembers(pid interface{}, opt *List
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/members/all", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/members/all", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -102,7 +101,7 @@ func (s *ProjectMembersService) GetProjectMember(pid interface{}, user int, opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/members/%d", url.QueryEscape(project), user)
+	u := fmt.Sprintf("projects/%s/members/%d", pathEscape(project), user)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -139,7 +138,7 @@ func (s *ProjectMembersService) AddProjectMember(pid interface{}, opt *AddProjec
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/members", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/members", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -172,7 +171,7 @@ func (s *ProjectMembersService) EditProjectMember(pid interface{}, user int, opt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/members/%d", url.QueryEscape(project), user)
+	u := fmt.Sprintf("projects/%s/members/%d", pathEscape(project), user)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -197,7 +196,7 @@ func (s *ProjectMembersService) DeleteProjectMember(pid interface{}, user int, o
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/members/%d", url.QueryEscape(project), user)
+	u := fmt.Sprintf("projects/%s/members/%d", pathEscape(project), user)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
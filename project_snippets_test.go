@@ -0,0 +1,26 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProjectSnippetFileContent(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/2/files/main/README.md/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "# Snippet\n")
+	})
+
+	content, _, err := client.ProjectSnippets.SnippetFileContent(1, 2, "main", "README.md")
+	if err != nil {
+		t.Fatalf("ProjectSnippets.SnippetFileContent returned error: %v", err)
+	}
+
+	if want := "# Snippet\n"; string(content) != want {
+		t.Errorf("ProjectSnippets.SnippetFileContent returned %q, want %q", content, want)
+	}
+}
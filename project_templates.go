@@ -0,0 +1,119 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// ProjectTemplatesService handles communication with the project templates
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/project_templates.html
+type ProjectTemplatesService struct {
+	client *Client
+}
+
+// ProjectTemplateTypeValue represents a type of project template.
+type ProjectTemplateTypeValue string
+
+// List of available project template types.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/project_templates.html
+const (
+	DockerfileProjectTemplate   ProjectTemplateTypeValue = "dockerfiles"
+	GitIgnoreProjectTemplate    ProjectTemplateTypeValue = "gitignores"
+	GitLabCIYMLProjectTemplate  ProjectTemplateTypeValue = "gitlab_ci_ymls"
+	LicenseProjectTemplate      ProjectTemplateTypeValue = "licenses"
+	IssueProjectTemplate        ProjectTemplateTypeValue = "issues"
+	MergeRequestProjectTemplate ProjectTemplateTypeValue = "merge_requests"
+)
+
+// ProjectTemplate represents a GitLab project template.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/project_templates.html
+type ProjectTemplate struct {
+	Key         string   `json:"key"`
+	Name        string   `json:"name"`
+	Nickname    string   `json:"nickname"`
+	Popular     bool     `json:"popular"`
+	HTMLURL     string   `json:"html_url"`
+	SourceURL   string   `json:"source_url"`
+	Description string   `json:"description"`
+	Conditions  []string `json:"conditions"`
+	Permissions []string `json:"permissions"`
+	Limitations []string `json:"limitations"`
+	Content     string   `json:"content"`
+}
+
+func (t ProjectTemplate) String() string {
+	return Stringify(t)
+}
+
+// ListProjectTemplatesOptions represents the available
+// ListTemplates() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-all-templates-of-a-particular-type
+type ListProjectTemplatesOptions ListOptions
+
+// ListTemplates gets all templates of a particular type available to a
+// project. This includes the issue and merge request description templates
+// of the project itself.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-all-templates-of-a-particular-type
+func (s *ProjectTemplatesService) ListTemplates(pid interface{}, templateType ProjectTemplateTypeValue, opt *ListProjectTemplatesOptions, options ...OptionFunc) ([]*ProjectTemplate, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/templates/%s", pathEscape(project), templateType)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pt []*ProjectTemplate
+	resp, err := s.client.Do(req, &pt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pt, resp, err
+}
+
+// GetProjectTemplateOptions represents the available GetTemplate() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-one-template-of-a-particular-type
+type GetProjectTemplateOptions struct {
+	SourceTemplateProjectID *int    `url:"source_template_project_id,omitempty" json:"source_template_project_id,omitempty"`
+	Project                 *string `url:"project,omitempty" json:"project,omitempty"`
+	Fullname                *string `url:"fullname,omitempty" json:"fullname,omitempty"`
+}
+
+// GetTemplate gets a single template of a particular type. For license
+// templates you can pass parameters to replace the license placeholders.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_templates.html#get-one-template-of-a-particular-type
+func (s *ProjectTemplatesService) GetTemplate(pid interface{}, templateType ProjectTemplateTypeValue, key string, opt *GetProjectTemplateOptions, options ...OptionFunc) (*ProjectTemplate, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/templates/%s/%s", pathEscape(project), templateType, pathEscape(key))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pt := new(ProjectTemplate)
+	resp, err := s.client.Do(req, pt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pt, resp, err
+}
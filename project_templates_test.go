@@ -0,0 +1,49 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectTemplates(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"key": "Bug", "name": "Bug"}, {"key": "Feature", "name": "Feature"}]`)
+	})
+
+	templates, _, err := client.ProjectTemplates.ListTemplates(1, IssueProjectTemplate, nil)
+	if err != nil {
+		t.Fatalf("ProjectTemplates.ListTemplates returned error: %v", err)
+	}
+
+	want := []*ProjectTemplate{{Key: "Bug", Name: "Bug"}, {Key: "Feature", Name: "Feature"}}
+	if !reflect.DeepEqual(want, templates) {
+		t.Errorf("ProjectTemplates.ListTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestGetProjectTemplate(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/templates/licenses/mit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/templates/licenses/mit?fullname=John+Doe")
+		fmt.Fprint(w, `{"key": "mit", "name": "MIT License", "content": "Copyright (c) John Doe"}`)
+	})
+
+	template, _, err := client.ProjectTemplates.GetTemplate(1, LicenseProjectTemplate, "mit", &GetProjectTemplateOptions{Fullname: String("John Doe")})
+	if err != nil {
+		t.Fatalf("ProjectTemplates.GetTemplate returned error: %v", err)
+	}
+
+	want := &ProjectTemplate{Key: "mit", Name: "MIT License", Content: "Copyright (c) John Doe"}
+	if !reflect.DeepEqual(want, template) {
+		t.Errorf("ProjectTemplates.GetTemplate returned %+v, want %+v", template, want)
+	}
+}
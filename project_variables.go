@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // ProjectVariablesService handles communication with the
@@ -54,7 +53,7 @@ func (s *ProjectVariablesService) ListVariables(pid interface{}, options ...Opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/variables", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/variables", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -79,7 +78,7 @@ func (s *ProjectVariablesService) GetVariable(pid interface{}, key string, optio
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/variables/%s", url.QueryEscape(project), url.QueryEscape(key))
+	u := fmt.Sprintf("projects/%s/variables/%s", pathEscape(project), pathEscape(key))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -116,7 +115,7 @@ func (s *ProjectVariablesService) CreateVariable(pid interface{}, opt *CreateVar
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/variables", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/variables", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -153,8 +152,8 @@ func (s *ProjectVariablesService) UpdateVariable(pid interface{}, key string, op
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/variables/%s",
-		url.QueryEscape(project),
-		url.QueryEscape(key),
+		pathEscape(project),
+		pathEscape(key),
 	)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
@@ -181,8 +180,8 @@ func (s *ProjectVariablesService) RemoveVariable(pid interface{}, key string, op
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/variables/%s",
-		url.QueryEscape(project),
-		url.QueryEscape(key),
+		pathEscape(project),
+		pathEscape(key),
 	)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
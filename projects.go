@@ -17,13 +17,8 @@
 package gitlab
 
 import (
-	"bytes"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"mime/multipart"
-	"net/url"
-	"os"
 	"time"
 )
 
@@ -39,57 +34,82 @@ type ProjectsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html
 type Project struct {
-	ID                                        int               `json:"id"`
-	Description                               string            `json:"description"`
-	DefaultBranch                             string            `json:"default_branch"`
-	Public                                    bool              `json:"public"`
-	Visibility                                VisibilityValue   `json:"visibility"`
-	SSHURLToRepo                              string            `json:"ssh_url_to_repo"`
-	HTTPURLToRepo                             string            `json:"http_url_to_repo"`
-	WebURL                                    string            `json:"web_url"`
-	TagList                                   []string          `json:"tag_list"`
-	Owner                                     *User             `json:"owner"`
-	Name                                      string            `json:"name"`
-	NameWithNamespace                         string            `json:"name_with_namespace"`
-	Path                                      string            `json:"path"`
-	PathWithNamespace                         string            `json:"path_with_namespace"`
-	IssuesEnabled                             bool              `json:"issues_enabled"`
-	OpenIssuesCount                           int               `json:"open_issues_count"`
-	MergeRequestsEnabled                      bool              `json:"merge_requests_enabled"`
-	ApprovalsBeforeMerge                      int               `json:"approvals_before_merge"`
-	JobsEnabled                               bool              `json:"jobs_enabled"`
-	WikiEnabled                               bool              `json:"wiki_enabled"`
-	SnippetsEnabled                           bool              `json:"snippets_enabled"`
-	ContainerRegistryEnabled                  bool              `json:"container_registry_enabled"`
-	CreatedAt                                 *time.Time        `json:"created_at,omitempty"`
-	LastActivityAt                            *time.Time        `json:"last_activity_at,omitempty"`
-	CreatorID                                 int               `json:"creator_id"`
-	Namespace                                 *ProjectNamespace `json:"namespace"`
-	ImportStatus                              string            `json:"import_status"`
-	ImportError                               string            `json:"import_error"`
-	Permissions                               *Permissions      `json:"permissions"`
-	Archived                                  bool              `json:"archived"`
-	AvatarURL                                 string            `json:"avatar_url"`
-	SharedRunnersEnabled                      bool              `json:"shared_runners_enabled"`
-	ForksCount                                int               `json:"forks_count"`
-	StarCount                                 int               `json:"star_count"`
-	RunnersToken                              string            `json:"runners_token"`
-	PublicBuilds                              bool              `json:"public_builds"`
-	OnlyAllowMergeIfPipelineSucceeds          bool              `json:"only_allow_merge_if_pipeline_succeeds"`
-	OnlyAllowMergeIfAllDiscussionsAreResolved bool              `json:"only_allow_merge_if_all_discussions_are_resolved"`
-	LFSEnabled                                bool              `json:"lfs_enabled"`
-	RequestAccessEnabled                      bool              `json:"request_access_enabled"`
-	MergeMethod                               MergeMethodValue  `json:"merge_method"`
-	ForkedFromProject                         *ForkParent       `json:"forked_from_project"`
-	SharedWithGroups                          []struct {
-		GroupID          int    `json:"group_id"`
-		GroupName        string `json:"group_name"`
-		GroupAccessLevel int    `json:"group_access_level"`
-	} `json:"shared_with_groups"`
-	Statistics       *ProjectStatistics `json:"statistics"`
-	Links            *Links             `json:"_links,omitempty"`
-	CIConfigPath     *string            `json:"ci_config_path"`
-	CustomAttributes []*CustomAttribute `json:"custom_attributes"`
+	ID                                        int                `json:"id"`
+	Description                               string             `json:"description"`
+	DefaultBranch                             string             `json:"default_branch"`
+	Public                                    bool               `json:"public"`
+	Visibility                                VisibilityValue    `json:"visibility"`
+	SSHURLToRepo                              string             `json:"ssh_url_to_repo"`
+	HTTPURLToRepo                             string             `json:"http_url_to_repo"`
+	WebURL                                    string             `json:"web_url"`
+	TagList                                   []string           `json:"tag_list"`
+	Topics                                    []string           `json:"topics"`
+	Owner                                     *User              `json:"owner"`
+	Name                                      string             `json:"name"`
+	NameWithNamespace                         string             `json:"name_with_namespace"`
+	Path                                      string             `json:"path"`
+	PathWithNamespace                         string             `json:"path_with_namespace"`
+	IssuesEnabled                             bool               `json:"issues_enabled"`
+	OpenIssuesCount                           int                `json:"open_issues_count"`
+	MergeRequestsEnabled                      bool               `json:"merge_requests_enabled"`
+	ApprovalsBeforeMerge                      int                `json:"approvals_before_merge"`
+	JobsEnabled                               bool               `json:"jobs_enabled"`
+	WikiEnabled                               bool               `json:"wiki_enabled"`
+	SnippetsEnabled                           bool               `json:"snippets_enabled"`
+	ContainerRegistryEnabled                  bool               `json:"container_registry_enabled"`
+	CreatedAt                                 *time.Time         `json:"created_at,omitempty"`
+	LastActivityAt                            *time.Time         `json:"last_activity_at,omitempty"`
+	CreatorID                                 int                `json:"creator_id"`
+	Namespace                                 *ProjectNamespace  `json:"namespace"`
+	ImportStatus                              string             `json:"import_status"`
+	ImportError                               string             `json:"import_error"`
+	Permissions                               *Permissions       `json:"permissions"`
+	Archived                                  bool               `json:"archived"`
+	AvatarURL                                 string             `json:"avatar_url"`
+	SharedRunnersEnabled                      bool               `json:"shared_runners_enabled"`
+	ForksCount                                int                `json:"forks_count"`
+	StarCount                                 int                `json:"star_count"`
+	RunnersToken                              string             `json:"runners_token"`
+	PublicBuilds                              bool               `json:"public_builds"`
+	OnlyAllowMergeIfPipelineSucceeds          bool               `json:"only_allow_merge_if_pipeline_succeeds"`
+	OnlyAllowMergeIfAllDiscussionsAreResolved bool               `json:"only_allow_merge_if_all_discussions_are_resolved"`
+	LFSEnabled                                bool               `json:"lfs_enabled"`
+	RequestAccessEnabled                      bool               `json:"request_access_enabled"`
+	MergeMethod                               MergeMethodValue   `json:"merge_method"`
+	ForkedFromProject                         *ForkParent        `json:"forked_from_project"`
+	SharedWithGroups                          []*SharedWithGroup `json:"shared_with_groups"`
+	Statistics                                *ProjectStatistics `json:"statistics"`
+	Links                                     *Links             `json:"_links,omitempty"`
+	CIConfigPath                              *string            `json:"ci_config_path"`
+	CustomAttributes                          []*CustomAttribute `json:"custom_attributes"`
+	IssuesAccessLevel                         AccessControlValue `json:"issues_access_level"`
+	RepositoryAccessLevel                     AccessControlValue `json:"repository_access_level"`
+	MergeRequestsAccessLevel                  AccessControlValue `json:"merge_requests_access_level"`
+	ForkingAccessLevel                        AccessControlValue `json:"forking_access_level"`
+	BuildsAccessLevel                         AccessControlValue `json:"builds_access_level"`
+	WikiAccessLevel                           AccessControlValue `json:"wiki_access_level"`
+	SnippetsAccessLevel                       AccessControlValue `json:"snippets_access_level"`
+	PagesAccessLevel                          AccessControlValue `json:"pages_access_level"`
+	OperationsAccessLevel                     AccessControlValue `json:"operations_access_level"`
+	AnalyticsAccessLevel                      AccessControlValue `json:"analytics_access_level"`
+	ContainerRegistryAccessLevel              AccessControlValue `json:"container_registry_access_level"`
+	RequirementsAccessLevel                   AccessControlValue `json:"requirements_access_level"`
+	SecurityAndComplianceAccessLevel          AccessControlValue `json:"security_and_compliance_access_level"`
+	ReleasesAccessLevel                       AccessControlValue `json:"releases_access_level"`
+	EnvironmentsAccessLevel                   AccessControlValue `json:"environments_access_level"`
+	FeatureFlagsAccessLevel                   AccessControlValue `json:"feature_flags_access_level"`
+	InfrastructureAccessLevel                 AccessControlValue `json:"infrastructure_access_level"`
+	MonitorAccessLevel                        AccessControlValue `json:"monitor_access_level"`
+	SquashOption                              SquashOptionValue  `json:"squash_option"`
+	SquashCommitTemplate                      string             `json:"squash_commit_template"`
+	MergeCommitTemplate                       string             `json:"merge_commit_template"`
+	RemoveSourceBranchAfterMerge              bool               `json:"remove_source_branch_after_merge"`
+	AllowMergeOnSkippedPipeline               bool               `json:"allow_merge_on_skipped_pipeline"`
+	BuildTimeout                              int                `json:"build_timeout"`
+	AutoCancelPendingPipelines                string             `json:"auto_cancel_pending_pipelines"`
+	BuildCoverageRegex                        string             `json:"build_coverage_regex"`
+	CISeparatedCaches                         bool               `json:"ci_separated_caches"`
+	CIAllowForkPipelinesToRunInParentProject  bool               `json:"ci_allow_fork_pipelines_to_run_in_parent_project"`
 }
 
 // Repository represents a repository.
@@ -226,7 +246,7 @@ func (s *ProjectsService) ListUserProjects(uid interface{}, opt *ListProjectsOpt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/projects", user)
+	u := fmt.Sprintf("users/%s/projects", pathEscape(user))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -269,7 +289,7 @@ func (s *ProjectsService) ListProjectsUsers(pid interface{}, opt *ListProjectUse
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/users", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/users", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -298,7 +318,7 @@ func (s *ProjectsService) GetProjectLanguages(pid interface{}, options ...Option
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/languages", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/languages", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -324,7 +344,7 @@ func (s *ProjectsService) GetProject(pid interface{}, options ...OptionFunc) (*P
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -348,7 +368,7 @@ type ProjectEvent struct {
 	Title          interface{} `json:"title"`
 	ProjectID      int         `json:"project_id"`
 	ActionName     string      `json:"action_name"`
-	TargetID       interface{} `json:"target_id"`
+	TargetID       int         `json:"target_id"`
 	TargetType     interface{} `json:"target_type"`
 	AuthorID       int         `json:"author_id"`
 	AuthorUsername string      `json:"author_username"`
@@ -385,7 +405,7 @@ func (s *ProjectsService) GetProjectEvents(pid interface{}, opt *GetProjectEvent
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/events", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/events", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -405,31 +425,60 @@ func (s *ProjectsService) GetProjectEvents(pid interface{}, opt *GetProjectEvent
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#create-project
 type CreateProjectOptions struct {
-	Name                                      *string           `url:"name,omitempty" json:"name,omitempty"`
-	Path                                      *string           `url:"path,omitempty" json:"path,omitempty"`
-	DefaultBranch                             *string           `url:"default_branch,omitempty" json:"default_branch,omitempty"`
-	NamespaceID                               *int              `url:"namespace_id,omitempty" json:"namespace_id,omitempty"`
-	Description                               *string           `url:"description,omitempty" json:"description,omitempty"`
-	IssuesEnabled                             *bool             `url:"issues_enabled,omitempty" json:"issues_enabled,omitempty"`
-	MergeRequestsEnabled                      *bool             `url:"merge_requests_enabled,omitempty" json:"merge_requests_enabled,omitempty"`
-	JobsEnabled                               *bool             `url:"jobs_enabled,omitempty" json:"jobs_enabled,omitempty"`
-	WikiEnabled                               *bool             `url:"wiki_enabled,omitempty" json:"wiki_enabled,omitempty"`
-	SnippetsEnabled                           *bool             `url:"snippets_enabled,omitempty" json:"snippets_enabled,omitempty"`
-	ResolveOutdatedDiffDiscussions            *bool             `url:"resolve_outdated_diff_discussions,omitempty" json:"resolve_outdated_diff_discussions,omitempty"`
-	ContainerRegistryEnabled                  *bool             `url:"container_registry_enabled,omitempty" json:"container_registry_enabled,omitempty"`
-	SharedRunnersEnabled                      *bool             `url:"shared_runners_enabled,omitempty" json:"shared_runners_enabled,omitempty"`
-	Visibility                                *VisibilityValue  `url:"visibility,omitempty" json:"visibility,omitempty"`
-	ImportURL                                 *string           `url:"import_url,omitempty" json:"import_url,omitempty"`
-	PublicBuilds                              *bool             `url:"public_builds,omitempty" json:"public_builds,omitempty"`
-	OnlyAllowMergeIfPipelineSucceeds          *bool             `url:"only_allow_merge_if_pipeline_succeeds,omitempty" json:"only_allow_merge_if_pipeline_succeeds,omitempty"`
-	OnlyAllowMergeIfAllDiscussionsAreResolved *bool             `url:"only_allow_merge_if_all_discussions_are_resolved,omitempty" json:"only_allow_merge_if_all_discussions_are_resolved,omitempty"`
-	MergeMethod                               *MergeMethodValue `url:"merge_method,omitempty" json:"merge_method,omitempty"`
-	LFSEnabled                                *bool             `url:"lfs_enabled,omitempty" json:"lfs_enabled,omitempty"`
-	RequestAccessEnabled                      *bool             `url:"request_access_enabled,omitempty" json:"request_access_enabled,omitempty"`
-	TagList                                   *[]string         `url:"tag_list,omitempty" json:"tag_list,omitempty"`
-	PrintingMergeRequestLinkEnabled           *bool             `url:"printing_merge_request_link_enabled,omitempty" json:"printing_merge_request_link_enabled,omitempty"`
-	CIConfigPath                              *string           `url:"ci_config_path,omitempty" json:"ci_config_path,omitempty"`
-	ApprovalsBeforeMerge                      *int              `url:"approvals_before_merge" json:"approvals_before_merge"`
+	Name                                      *string             `url:"name,omitempty" json:"name,omitempty"`
+	Path                                      *string             `url:"path,omitempty" json:"path,omitempty"`
+	DefaultBranch                             *string             `url:"default_branch,omitempty" json:"default_branch,omitempty"`
+	NamespaceID                               *int                `url:"namespace_id,omitempty" json:"namespace_id,omitempty"`
+	Description                               *string             `url:"description,omitempty" json:"description,omitempty"`
+	IssuesEnabled                             *bool               `url:"issues_enabled,omitempty" json:"issues_enabled,omitempty"`
+	MergeRequestsEnabled                      *bool               `url:"merge_requests_enabled,omitempty" json:"merge_requests_enabled,omitempty"`
+	JobsEnabled                               *bool               `url:"jobs_enabled,omitempty" json:"jobs_enabled,omitempty"`
+	WikiEnabled                               *bool               `url:"wiki_enabled,omitempty" json:"wiki_enabled,omitempty"`
+	SnippetsEnabled                           *bool               `url:"snippets_enabled,omitempty" json:"snippets_enabled,omitempty"`
+	ResolveOutdatedDiffDiscussions            *bool               `url:"resolve_outdated_diff_discussions,omitempty" json:"resolve_outdated_diff_discussions,omitempty"`
+	ContainerRegistryEnabled                  *bool               `url:"container_registry_enabled,omitempty" json:"container_registry_enabled,omitempty"`
+	SharedRunnersEnabled                      *bool               `url:"shared_runners_enabled,omitempty" json:"shared_runners_enabled,omitempty"`
+	Visibility                                *VisibilityValue    `url:"visibility,omitempty" json:"visibility,omitempty"`
+	ImportURL                                 *string             `url:"import_url,omitempty" json:"import_url,omitempty"`
+	PublicBuilds                              *bool               `url:"public_builds,omitempty" json:"public_builds,omitempty"`
+	OnlyAllowMergeIfPipelineSucceeds          *bool               `url:"only_allow_merge_if_pipeline_succeeds,omitempty" json:"only_allow_merge_if_pipeline_succeeds,omitempty"`
+	OnlyAllowMergeIfAllDiscussionsAreResolved *bool               `url:"only_allow_merge_if_all_discussions_are_resolved,omitempty" json:"only_allow_merge_if_all_discussions_are_resolved,omitempty"`
+	MergeMethod                               *MergeMethodValue   `url:"merge_method,omitempty" json:"merge_method,omitempty"`
+	LFSEnabled                                *bool               `url:"lfs_enabled,omitempty" json:"lfs_enabled,omitempty"`
+	RequestAccessEnabled                      *bool               `url:"request_access_enabled,omitempty" json:"request_access_enabled,omitempty"`
+	TagList                                   *[]string           `url:"tag_list,omitempty" json:"tag_list,omitempty"`
+	Topics                                    *[]string           `url:"topics,omitempty" json:"topics,omitempty"`
+	PrintingMergeRequestLinkEnabled           *bool               `url:"printing_merge_request_link_enabled,omitempty" json:"printing_merge_request_link_enabled,omitempty"`
+	CIConfigPath                              *string             `url:"ci_config_path,omitempty" json:"ci_config_path,omitempty"`
+	ApprovalsBeforeMerge                      *int                `url:"approvals_before_merge,omitempty" json:"approvals_before_merge,omitempty"`
+	IssuesAccessLevel                         *AccessControlValue `url:"issues_access_level,omitempty" json:"issues_access_level,omitempty"`
+	RepositoryAccessLevel                     *AccessControlValue `url:"repository_access_level,omitempty" json:"repository_access_level,omitempty"`
+	MergeRequestsAccessLevel                  *AccessControlValue `url:"merge_requests_access_level,omitempty" json:"merge_requests_access_level,omitempty"`
+	ForkingAccessLevel                        *AccessControlValue `url:"forking_access_level,omitempty" json:"forking_access_level,omitempty"`
+	BuildsAccessLevel                         *AccessControlValue `url:"builds_access_level,omitempty" json:"builds_access_level,omitempty"`
+	WikiAccessLevel                           *AccessControlValue `url:"wiki_access_level,omitempty" json:"wiki_access_level,omitempty"`
+	SnippetsAccessLevel                       *AccessControlValue `url:"snippets_access_level,omitempty" json:"snippets_access_level,omitempty"`
+	PagesAccessLevel                          *AccessControlValue `url:"pages_access_level,omitempty" json:"pages_access_level,omitempty"`
+	OperationsAccessLevel                     *AccessControlValue `url:"operations_access_level,omitempty" json:"operations_access_level,omitempty"`
+	AnalyticsAccessLevel                      *AccessControlValue `url:"analytics_access_level,omitempty" json:"analytics_access_level,omitempty"`
+	ContainerRegistryAccessLevel              *AccessControlValue `url:"container_registry_access_level,omitempty" json:"container_registry_access_level,omitempty"`
+	RequirementsAccessLevel                   *AccessControlValue `url:"requirements_access_level,omitempty" json:"requirements_access_level,omitempty"`
+	SecurityAndComplianceAccessLevel          *AccessControlValue `url:"security_and_compliance_access_level,omitempty" json:"security_and_compliance_access_level,omitempty"`
+	ReleasesAccessLevel                       *AccessControlValue `url:"releases_access_level,omitempty" json:"releases_access_level,omitempty"`
+	EnvironmentsAccessLevel                   *AccessControlValue `url:"environments_access_level,omitempty" json:"environments_access_level,omitempty"`
+	FeatureFlagsAccessLevel                   *AccessControlValue `url:"feature_flags_access_level,omitempty" json:"feature_flags_access_level,omitempty"`
+	InfrastructureAccessLevel                 *AccessControlValue `url:"infrastructure_access_level,omitempty" json:"infrastructure_access_level,omitempty"`
+	MonitorAccessLevel                        *AccessControlValue `url:"monitor_access_level,omitempty" json:"monitor_access_level,omitempty"`
+	SquashOption                              *SquashOptionValue  `url:"squash_option,omitempty" json:"squash_option,omitempty"`
+	SquashCommitTemplate                      *string             `url:"squash_commit_template,omitempty" json:"squash_commit_template,omitempty"`
+	MergeCommitTemplate                       *string             `url:"merge_commit_template,omitempty" json:"merge_commit_template,omitempty"`
+	RemoveSourceBranchAfterMerge              *bool               `url:"remove_source_branch_after_merge,omitempty" json:"remove_source_branch_after_merge,omitempty"`
+	AllowMergeOnSkippedPipeline               *bool               `url:"allow_merge_on_skipped_pipeline,omitempty" json:"allow_merge_on_skipped_pipeline,omitempty"`
+	BuildTimeout                              *int                `url:"build_timeout,omitempty" json:"build_timeout,omitempty"`
+	AutoCancelPendingPipelines                *string             `url:"auto_cancel_pending_pipelines,omitempty" json:"auto_cancel_pending_pipelines,omitempty"`
+	BuildCoverageRegex                        *string             `url:"build_coverage_regex,omitempty" json:"build_coverage_regex,omitempty"`
+	CISeparatedCaches                         *bool               `url:"ci_separated_caches,omitempty" json:"ci_separated_caches,omitempty"`
+	CIAllowForkPipelinesToRunInParentProject  *bool               `url:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty" json:"ci_allow_fork_pipelines_to_run_in_parent_project,omitempty"`
 }
 
 // CreateProject creates a new project owned by the authenticated user.
@@ -492,7 +541,7 @@ func (s *ProjectsService) EditProject(pid interface{}, opt *EditProjectOptions,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -517,7 +566,7 @@ func (s *ProjectsService) ForkProject(pid interface{}, options ...OptionFunc) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/fork", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/fork", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -542,7 +591,7 @@ func (s *ProjectsService) StarProject(pid interface{}, options ...OptionFunc) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/star", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/star", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -567,7 +616,7 @@ func (s *ProjectsService) UnstarProject(pid interface{}, options ...OptionFunc)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/unstar", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/unstar", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -593,7 +642,7 @@ func (s *ProjectsService) ArchiveProject(pid interface{}, options ...OptionFunc)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/archive", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/archive", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -619,7 +668,7 @@ func (s *ProjectsService) UnarchiveProject(pid interface{}, options ...OptionFun
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/unarchive", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/unarchive", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -644,7 +693,7 @@ func (s *ProjectsService) DeleteProject(pid interface{}, options ...OptionFunc)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -654,13 +703,24 @@ func (s *ProjectsService) DeleteProject(pid interface{}, options ...OptionFunc)
 	return s.client.Do(req, nil)
 }
 
+// SharedWithGroup represents a group a project or group is shared with.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#share-project-with-group
+type SharedWithGroup struct {
+	GroupID          int      `json:"group_id"`
+	GroupName        string   `json:"group_name"`
+	GroupFullPath    string   `json:"group_full_path"`
+	GroupAccessLevel int      `json:"group_access_level"`
+	ExpiresAt        *ISOTime `json:"expires_at"`
+}
+
 // ShareWithGroupOptions represents options to share project with groups
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#share-project-with-group
 type ShareWithGroupOptions struct {
-	GroupID     *int              `url:"group_id" json:"group_id"`
-	GroupAccess *AccessLevelValue `url:"group_access" json:"group_access"`
-	ExpiresAt   *string           `url:"expires_at" json:"expires_at"`
+	GroupID     *int              `url:"group_id,omitempty" json:"group_id,omitempty"`
+	GroupAccess *AccessLevelValue `url:"group_access,omitempty" json:"group_access,omitempty"`
+	ExpiresAt   *string           `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 }
 
 // ShareProjectWithGroup allows to share a project with a group.
@@ -671,7 +731,7 @@ func (s *ProjectsService) ShareProjectWithGroup(pid interface{}, opt *ShareWithG
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/share", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/share", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -689,7 +749,7 @@ func (s *ProjectsService) DeleteSharedProjectFromGroup(pid interface{}, groupID
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/share/%d", url.QueryEscape(project), groupID)
+	u := fmt.Sprintf("projects/%s/share/%d", pathEscape(project), groupID)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -748,7 +808,7 @@ func (s *ProjectsService) ListProjectHooks(pid interface{}, opt *ListProjectHook
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/hooks", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/hooks", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -773,7 +833,7 @@ func (s *ProjectsService) GetProjectHook(pid interface{}, hook int, options ...O
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/hooks/%d", url.QueryEscape(project), hook)
+	u := fmt.Sprintf("projects/%s/hooks/%d", pathEscape(project), hook)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -817,7 +877,7 @@ func (s *ProjectsService) AddProjectHook(pid interface{}, opt *AddProjectHookOpt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/hooks", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/hooks", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -861,7 +921,7 @@ func (s *ProjectsService) EditProjectHook(pid interface{}, hook int, opt *EditPr
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/hooks/%d", url.QueryEscape(project), hook)
+	u := fmt.Sprintf("projects/%s/hooks/%d", pathEscape(project), hook)
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -887,7 +947,7 @@ func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options .
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/hooks/%d", url.QueryEscape(project), hook)
+	u := fmt.Sprintf("projects/%s/hooks/%d", pathEscape(project), hook)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -909,13 +969,22 @@ type ProjectForkRelation struct {
 	UpdatedAt           *time.Time `json:"updated_at"`
 }
 
-// CreateProjectForkRelation creates a forked from/to relation between
-// existing projects.
+// CreateProjectForkRelation marks the project as a fork of the project fork,
+// creating a forked from/to relation between existing projects. Both projects
+// can be given by ID or by path. Available only for admins.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#create-a-forked-fromto-relation-between-existing-projects.
-func (s *ProjectsService) CreateProjectForkRelation(pid int, fork int, options ...OptionFunc) (*ProjectForkRelation, *Response, error) {
-	u := fmt.Sprintf("projects/%d/fork/%d", pid, fork)
+func (s *ProjectsService) CreateProjectForkRelation(pid interface{}, fork interface{}, options ...OptionFunc) (*ProjectForkRelation, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	forkedFrom, err := parseID(fork)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/fork/%s", pathEscape(project), pathEscape(forkedFrom))
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -931,12 +1000,17 @@ func (s *ProjectsService) CreateProjectForkRelation(pid int, fork int, options .
 	return pfr, resp, err
 }
 
-// DeleteProjectForkRelation deletes an existing forked from relationship.
+// DeleteProjectForkRelation deletes an existing forked from relationship, so
+// the project is no longer a fork.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#delete-an-existing-forked-from-relationship
-func (s *ProjectsService) DeleteProjectForkRelation(pid int, options ...OptionFunc) (*Response, error) {
-	u := fmt.Sprintf("projects/%d/fork", pid)
+func (s *ProjectsService) DeleteProjectForkRelation(pid interface{}, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/fork", pathEscape(project))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -955,53 +1029,117 @@ type ProjectFile struct {
 	Markdown string `json:"markdown"`
 }
 
-// UploadFile upload a file from disk
+// UploadFile uploads a file to a project, so it can be referenced in the
+// Markdown of issues, merge requests and comments. The content is read from
+// content and uploaded using the given filename.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#upload-a-file
-func (s *ProjectsService) UploadFile(pid interface{}, file string, options ...OptionFunc) (*ProjectFile, *Response, error) {
+func (s *ProjectsService) UploadFile(pid interface{}, content io.Reader, filename string, options ...OptionFunc) (*ProjectFile, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/uploads", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/uploads", pathEscape(project))
 
-	f, err := os.Open(file)
+	req, err := s.client.UploadRequest("POST", u, content, filename, "file", nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer f.Close()
 
-	b := &bytes.Buffer{}
-	w := multipart.NewWriter(b)
+	uf := &ProjectFile{}
+	resp, err := s.client.Do(req, uf)
+	if err != nil {
+		return nil, resp, err
+	}
 
-	fw, err := w.CreateFormFile("file", file)
+	return uf, resp, nil
+}
+
+// UploadAvatar uploads an avatar for a project. The content is read from
+// avatar and uploaded using the given filename.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#upload-a-project-avatar
+func (s *ProjectsService) UploadAvatar(pid interface{}, avatar io.Reader, filename string, options ...OptionFunc) (*Project, *Response, error) {
+	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
 
-	_, err = io.Copy(fw, f)
+	req, err := s.client.UploadRequest("PUT", u, avatar, filename, "avatar", nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
-	w.Close()
 
-	req, err := s.client.NewRequest("", u, nil, options)
+	p := new(Project)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
+
+// RemoveAvatar removes the avatar of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#remove-a-project-avatar
+func (s *ProjectsService) RemoveAvatar(pid interface{}, options ...OptionFunc) (*Project, *Response, error) {
+	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
+	u := fmt.Sprintf("projects/%s", pathEscape(project))
 
-	req.Body = ioutil.NopCloser(b)
-	req.ContentLength = int64(b.Len())
-	req.Header.Set("Content-Type", w.FormDataContentType())
-	req.Method = "POST"
+	req, err := s.client.removeAvatarRequest(u, options)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	uf := &ProjectFile{}
-	resp, err := s.client.Do(req, uf)
+	p := new(Project)
+	resp, err := s.client.Do(req, p)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return uf, resp, nil
+	return p, resp, err
+}
+
+// ListProjectTransferLocationsOptions represents the available
+// ListProjectTransferLocations() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#retrieve-project-transfer-locations
+type ListProjectTransferLocationsOptions struct {
+	ListOptions
+	Search *string `url:"search,omitempty" json:"search,omitempty"`
+}
+
+// ListProjectTransferLocations gets the groups the authenticated user can
+// transfer the project to.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#retrieve-project-transfer-locations
+func (s *ProjectsService) ListProjectTransferLocations(pid interface{}, opt *ListProjectTransferLocationsOptions, options ...OptionFunc) ([]*Group, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/transfer_locations", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gs []*Group
+	resp, err := s.client.Do(req, &gs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gs, resp, err
 }
 
 // ListProjectForks gets a list of project forks.
@@ -1013,7 +1151,7 @@ func (s *ProjectsService) ListProjectForks(pid interface{}, opt *ListProjectsOpt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/forks", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/forks", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -1056,7 +1194,7 @@ func (s *ProjectsService) GetProjectPushRules(pid interface{}, options ...Option
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/push_rule", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/push_rule", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -1097,7 +1235,7 @@ func (s *ProjectsService) AddProjectPushRule(pid interface{}, opt *AddProjectPus
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/push_rule", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/push_rule", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -1138,7 +1276,7 @@ func (s *ProjectsService) EditProjectPushRule(pid interface{}, opt *EditProjectP
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/push_rule", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/push_rule", pathEscape(project))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -1165,7 +1303,7 @@ func (s *ProjectsService) DeleteProjectPushRule(pid interface{}, options ...Opti
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/push_rule", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/push_rule", pathEscape(project))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -1185,6 +1323,9 @@ type ProjectApprovals struct {
 	ApprovalsBeforeMerge                      int                          `json:"approvals_before_merge"`
 	ResetApprovalsOnPush                      bool                         `json:"reset_approvals_on_push"`
 	DisableOverridingApproversPerMergeRequest bool                         `json:"disable_overriding_approvers_per_merge_request"`
+	MergeRequestsAuthorApproval               bool                         `json:"merge_requests_author_approval"`
+	MergeRequestsDisableCommittersApproval    bool                         `json:"merge_requests_disable_committers_approval"`
+	RequirePasswordToApprove                  bool                         `json:"require_password_to_approve"`
 }
 
 // GetApprovalConfiguration get the approval configuration for a project.
@@ -1196,7 +1337,7 @@ func (s *ProjectsService) GetApprovalConfiguration(pid interface{}, options ...O
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/approvals", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/approvals", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -1213,7 +1354,7 @@ func (s *ProjectsService) GetApprovalConfiguration(pid interface{}, options ...O
 }
 
 // ChangeApprovalConfigurationOptions represents the available
-// ApprovalConfiguration() options.
+// ChangeApprovalConfiguration() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#change-configuration
@@ -1221,6 +1362,9 @@ type ChangeApprovalConfigurationOptions struct {
 	ApprovalsBeforeMerge                      *int  `url:"approvals_before_merge,omitempty" json:"approvals_before_merge,omitempty"`
 	ResetApprovalsOnPush                      *bool `url:"reset_approvals_on_push,omitempty" json:"reset_approvals_on_push,omitempty"`
 	DisableOverridingApproversPerMergeRequest *bool `url:"disable_overriding_approvers_per_merge_request,omitempty" json:"disable_overriding_approvers_per_merge_request,omitempty"`
+	MergeRequestsAuthorApproval               *bool `url:"merge_requests_author_approval,omitempty" json:"merge_requests_author_approval,omitempty"`
+	MergeRequestsDisableCommittersApproval    *bool `url:"merge_requests_disable_committers_approval,omitempty" json:"merge_requests_disable_committers_approval,omitempty"`
+	RequirePasswordToApprove                  *bool `url:"require_password_to_approve,omitempty" json:"require_password_to_approve,omitempty"`
 }
 
 // ChangeApprovalConfiguration updates the approval configuration for a project.
@@ -1232,7 +1376,7 @@ func (s *ProjectsService) ChangeApprovalConfiguration(pid interface{}, opt *Chan
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/approvals", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/approvals", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -1267,7 +1411,7 @@ func (s *ProjectsService) ChangeAllowedApprovers(pid interface{}, opt *ChangeAll
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/approvers", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/approvers", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -1282,3 +1426,144 @@ func (s *ProjectsService) ChangeAllowedApprovers(pid interface{}, opt *ChangeAll
 
 	return pa, resp, err
 }
+
+// StartHousekeepingProjectOptions represents the available
+// StartHousekeepingProject() options. Task is either "eager" (the default)
+// or "prune", which also prunes unreachable objects.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#start-the-housekeeping-task-for-a-project
+type StartHousekeepingProjectOptions struct {
+	Task *string `url:"task,omitempty" json:"task,omitempty"`
+}
+
+// StartHousekeepingProject starts the housekeeping task for a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#start-the-housekeeping-task-for-a-project
+func (s *ProjectsService) StartHousekeepingProject(pid interface{}, opt *StartHousekeepingProjectOptions, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/housekeeping", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ProjectPullMirrorDetails represents the pull mirror configuration and
+// status of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_pull_mirroring.html
+type ProjectPullMirrorDetails struct {
+	ID                               int        `json:"id"`
+	URL                              string     `json:"url"`
+	Enabled                          bool       `json:"enabled"`
+	UpdateStatus                     string     `json:"update_status"`
+	LastError                        string     `json:"last_error"`
+	LastUpdateAt                     *time.Time `json:"last_update_at"`
+	LastUpdateStartedAt              *time.Time `json:"last_update_started_at"`
+	LastSuccessfulUpdateAt           *time.Time `json:"last_successful_update_at"`
+	MirrorTriggerBuilds              bool       `json:"mirror_trigger_builds"`
+	OnlyMirrorProtectedBranches      bool       `json:"only_mirror_protected_branches"`
+	MirrorOverwritesDivergedBranches bool       `json:"mirror_overwrites_diverged_branches"`
+	MirrorBranchRegex                string     `json:"mirror_branch_regex"`
+}
+
+func (m ProjectPullMirrorDetails) String() string {
+	return Stringify(m)
+}
+
+// GetProjectPullMirrorDetails gets the pull mirror configuration and status
+// of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_pull_mirroring.html#get-a-projects-pull-mirror-details
+func (s *ProjectsService) GetProjectPullMirrorDetails(pid interface{}, options ...OptionFunc) (*ProjectPullMirrorDetails, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/mirror/pull", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(ProjectPullMirrorDetails)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// ConfigureProjectPullMirrorOptions represents the available
+// ConfigureProjectPullMirror() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_pull_mirroring.html#configure-pull-mirroring-for-a-project
+type ConfigureProjectPullMirrorOptions struct {
+	Enabled                          *bool   `url:"enabled,omitempty" json:"enabled,omitempty"`
+	URL                              *string `url:"url,omitempty" json:"url,omitempty"`
+	AuthUser                         *string `url:"auth_user,omitempty" json:"auth_user,omitempty"`
+	AuthPassword                     *string `url:"auth_password,omitempty" json:"auth_password,omitempty"`
+	MirrorTriggerBuilds              *bool   `url:"mirror_trigger_builds,omitempty" json:"mirror_trigger_builds,omitempty"`
+	OnlyMirrorProtectedBranches      *bool   `url:"only_mirror_protected_branches,omitempty" json:"only_mirror_protected_branches,omitempty"`
+	MirrorOverwritesDivergedBranches *bool   `url:"mirror_overwrites_diverged_branches,omitempty" json:"mirror_overwrites_diverged_branches,omitempty"`
+	MirrorBranchRegex                *string `url:"mirror_branch_regex,omitempty" json:"mirror_branch_regex,omitempty"`
+}
+
+// ConfigureProjectPullMirror updates the pull mirror configuration of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_pull_mirroring.html#configure-pull-mirroring-for-a-project
+func (s *ProjectsService) ConfigureProjectPullMirror(pid interface{}, opt *ConfigureProjectPullMirrorOptions, options ...OptionFunc) (*ProjectPullMirrorDetails, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/mirror/pull", pathEscape(project))
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(ProjectPullMirrorDetails)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// StartMirroringProject starts the pull mirroring process for a project.
+// The update runs asynchronously.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_pull_mirroring.html#start-the-pull-mirroring-process-for-a-project
+func (s *ProjectsService) StartMirroringProject(pid interface{}, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/mirror/pull", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
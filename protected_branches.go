@@ -18,7 +18,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // ProtectedBranchesService handles communication with the protected branch
@@ -36,8 +35,11 @@ type ProtectedBranchesService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/protected_branches.html#protected-branches-api
 type BranchAccessDescription struct {
+	ID                     int              `json:"id"`
 	AccessLevel            AccessLevelValue `json:"access_level"`
 	AccessLevelDescription string           `json:"access_level_description"`
+	UserID                 int              `json:"user_id"`
+	GroupID                int              `json:"group_id"`
 }
 
 // ProtectedBranch represents a protected branch.
@@ -45,9 +47,12 @@ type BranchAccessDescription struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/protected_branches.html#list-protected-branches
 type ProtectedBranch struct {
-	Name              string                     `json:"name"`
-	PushAccessLevels  []*BranchAccessDescription `json:"push_access_levels"`
-	MergeAccessLevels []*BranchAccessDescription `json:"merge_access_levels"`
+	Name                      string                     `json:"name"`
+	PushAccessLevels          []*BranchAccessDescription `json:"push_access_levels"`
+	MergeAccessLevels         []*BranchAccessDescription `json:"merge_access_levels"`
+	UnprotectAccessLevels     []*BranchAccessDescription `json:"unprotect_access_levels"`
+	AllowForcePush            bool                       `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool                       `json:"code_owner_approval_required"`
 }
 
 // ListProtectedBranchesOptions represents the available ListProtectedBranches()
@@ -66,7 +71,7 @@ func (s *ProtectedBranchesService) ListProtectedBranches(pid interface{}, opt *L
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/protected_branches", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/protected_branches", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -91,7 +96,7 @@ func (s *ProtectedBranchesService) GetProtectedBranch(pid interface{}, branch st
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/protected_branches/%s", url.QueryEscape(project), branch)
+	u := fmt.Sprintf("projects/%s/protected_branches/%s", pathEscape(project), branch)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -128,7 +133,7 @@ func (s *ProtectedBranchesService) ProtectRepositoryBranches(pid interface{}, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/protected_branches", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/protected_branches", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -154,7 +159,7 @@ func (s *ProtectedBranchesService) UnprotectRepositoryBranches(pid interface{},
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/protected_branches/%s", url.QueryEscape(project), branch)
+	u := fmt.Sprintf("projects/%s/protected_branches/%s", pathEscape(project), branch)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -163,3 +168,58 @@ func (s *ProtectedBranchesService) UnprotectRepositoryBranches(pid interface{},
 
 	return s.client.Do(req, nil)
 }
+
+// BranchPermissionOptions represents a branch permission option. Set ID and
+// Destroy to remove an existing permission, or leave ID unset to add a new
+// one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/protected_branches.html#update-a-protected-branch
+type BranchPermissionOptions struct {
+	ID          *int              `url:"id,omitempty" json:"id,omitempty"`
+	UserID      *int              `url:"user_id,omitempty" json:"user_id,omitempty"`
+	GroupID     *int              `url:"group_id,omitempty" json:"group_id,omitempty"`
+	AccessLevel *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+	Destroy     *bool             `url:"_destroy,omitempty" json:"_destroy,omitempty"`
+}
+
+// UpdateProtectedBranchOptions represents the available
+// UpdateProtectedBranch() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/protected_branches.html#update-a-protected-branch
+type UpdateProtectedBranchOptions struct {
+	Name                      *string                     `url:"name,omitempty" json:"name,omitempty"`
+	AllowForcePush            *bool                       `url:"allow_force_push,omitempty" json:"allow_force_push,omitempty"`
+	CodeOwnerApprovalRequired *bool                       `url:"code_owner_approval_required,omitempty" json:"code_owner_approval_required,omitempty"`
+	AllowedToPush             *[]*BranchPermissionOptions `url:"allowed_to_push,omitempty" json:"allowed_to_push,omitempty"`
+	AllowedToMerge            *[]*BranchPermissionOptions `url:"allowed_to_merge,omitempty" json:"allowed_to_merge,omitempty"`
+	AllowedToUnprotect        *[]*BranchPermissionOptions `url:"allowed_to_unprotect,omitempty" json:"allowed_to_unprotect,omitempty"`
+}
+
+// UpdateProtectedBranch updates the settings of a protected branch in place,
+// so access levels can be added or removed without unprotecting the branch
+// first.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/protected_branches.html#update-a-protected-branch
+func (s *ProtectedBranchesService) UpdateProtectedBranch(pid interface{}, branch string, opt *UpdateProtectedBranchOptions, options ...OptionFunc) (*ProtectedBranch, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/protected_branches/%s", pathEscape(project), pathEscape(branch))
+
+	req, err := s.client.NewRequest("PATCH", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(ProtectedBranch)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
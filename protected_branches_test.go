@@ -0,0 +1,43 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateProtectedBranch(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/release%2F1.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"allowed_to_push":[{"id":5,"_destroy":true},{"user_id":7}]}`)
+		fmt.Fprint(w, `{
+			"name": "release/1.0",
+			"push_access_levels": [{"id": 6, "access_level": 0, "user_id": 7}],
+			"merge_access_levels": [{"id": 3, "access_level": 40, "access_level_description": "Maintainers"}]
+		}`)
+	})
+
+	opt := &UpdateProtectedBranchOptions{
+		AllowedToPush: &[]*BranchPermissionOptions{
+			{ID: Int(5), Destroy: Bool(true)},
+			{UserID: Int(7)},
+		},
+	}
+	branch, _, err := client.ProtectedBranches.UpdateProtectedBranch(1, "release/1.0", opt)
+	if err != nil {
+		t.Fatalf("ProtectedBranches.UpdateProtectedBranch returned error: %v", err)
+	}
+
+	want := &ProtectedBranch{
+		Name:              "release/1.0",
+		PushAccessLevels:  []*BranchAccessDescription{{ID: 6, AccessLevel: NoPermissions, UserID: 7}},
+		MergeAccessLevels: []*BranchAccessDescription{{ID: 3, AccessLevel: MaintainerPermissions, AccessLevelDescription: "Maintainers"}},
+	}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("ProtectedBranches.UpdateProtectedBranch returned %+v, want %+v", branch, want)
+	}
+}
@@ -0,0 +1,338 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// RecorderMode represents the mode a Recorder operates in.
+type RecorderMode int
+
+// List of available recorder modes.
+const (
+	// RecordMode sends all requests to the GitLab server and records the
+	// interactions, which are written to the fixture file when the recorder
+	// is stopped.
+	RecordMode RecorderMode = iota
+
+	// ReplayMode never sends any requests, but replays the responses of the
+	// interactions recorded in the fixture file instead.
+	ReplayMode
+)
+
+// scrubbedHeaders lists the headers that are never written to a fixture file.
+var scrubbedHeaders = []string{"Authorization", "Job-Token", "Private-Token", "Sudo", "Set-Cookie", "Cookie"}
+
+// scrubbedValue replaces the values of token query parameters and JSON fields
+// in a fixture file.
+const scrubbedValue = "REDACTED"
+
+// RecordedRequest represents a request as stored in a fixture file.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse represents a response as stored in a fixture file.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction represents a single recorded request and its response.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+
+	replayed bool
+}
+
+// Recorder is an http.RoundTripper that records API interactions to a
+// fixture file, or replays previously recorded interactions. Before
+// interactions are written to the fixture file, the credential headers are
+// removed and the values of token query parameters and JSON fields (named
+// "token" or ending in "_token") are replaced. Other secrets, like passwords,
+// are not detected, so review fixture files before committing them.
+//
+// A Recorder is meant to be used as the transport of the http.Client that is
+// passed to one of the client constructors:
+//
+//	r, err := gitlab.NewRecorder("fixtures/projects.json", gitlab.ReplayMode, nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer r.Stop()
+//
+//	git := gitlab.NewClient(&http.Client{Transport: r}, "token")
+type Recorder struct {
+	path      string
+	mode      RecorderMode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+}
+
+// NewRecorder returns a new Recorder using the given fixture file. In
+// ReplayMode the fixture file is loaded immediately. In RecordMode requests
+// are send using the given transport, or http.DefaultTransport if nil.
+func NewRecorder(path string, mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: transport,
+	}
+
+	if mode == ReplayMode {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture file %s: %v", path, err)
+		}
+	}
+
+	return r, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so the body is read using
+	// a clone of the request, which is sent instead.
+	clone := req.Clone(req.Context())
+	body, err := readRequestBody(clone)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ReplayMode {
+		return r.replay(req, body)
+	}
+	return r.record(clone, body)
+}
+
+// Stop writes all recorded interactions to the fixture file. It is a no-op
+// when the recorder is in ReplayMode.
+func (r *Recorder) Stop() error {
+	if r.mode != RecordMode {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, data, os.FileMode(0644))
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: &RecordedRequest{
+			Method:  req.Method,
+			URL:     scrubURL(req.URL),
+			Headers: scrubHeaders(req.Header),
+			Body:    string(scrubBody(body)),
+		},
+		Response: &RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    scrubHeaders(resp.Header),
+			Body:       string(scrubBody(respBody)),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	// The recorded requests are scrubbed, so scrub the request the same way
+	// before comparing them.
+	u := scrubURL(req.URL)
+	body = scrubBody(body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.interactions {
+		if i.replayed || i.Request.Method != req.Method || i.Request.URL != u {
+			continue
+		}
+		if i.Request.Body != string(body) {
+			continue
+		}
+		i.replayed = true
+
+		header := make(http.Header)
+		for k, v := range i.Response.Headers {
+			header[k] = v
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(i.Response.Body))),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction found for %s %s", req.Method, req.URL)
+}
+
+// readRequestBody reads the body of the request and replaces it with a fresh
+// reader, so the request can still be send afterwards.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// scrubHeaders returns a copy of the headers without any credentials.
+func scrubHeaders(h http.Header) http.Header {
+	scrubbed := make(http.Header, len(h))
+	for k, v := range h {
+		scrubbed[k] = v
+	}
+	for _, k := range scrubbedHeaders {
+		scrubbed.Del(k)
+	}
+	return scrubbed
+}
+
+// isTokenKey reports whether the query parameter or JSON field k holds a
+// token.
+func isTokenKey(k string) bool {
+	k = strings.ToLower(k)
+	return k == "token" || strings.HasSuffix(k, "_token")
+}
+
+// scrubURL returns the URL with the values of any token query parameters
+// replaced.
+func scrubURL(u *url.URL) string {
+	q := u.Query()
+	scrubbed := false
+	for k := range q {
+		if isTokenKey(k) {
+			q.Set(k, scrubbedValue)
+			scrubbed = true
+		}
+	}
+	if !scrubbed {
+		return u.String()
+	}
+
+	c := *u
+	c.RawQuery = q.Encode()
+	return c.String()
+}
+
+// scrubBody returns the body with the values of any token fields replaced.
+// Bodies that are not JSON, or don't contain any tokens, are returned as is.
+func scrubBody(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	if !scrubJSON(v) {
+		return body
+	}
+
+	scrubbed, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+// scrubJSON replaces the values of token fields in the decoded JSON value v
+// and reports whether anything was replaced.
+func scrubJSON(v interface{}) bool {
+	scrubbed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if _, ok := e.(string); ok && isTokenKey(k) {
+				v[k] = scrubbedValue
+				scrubbed = true
+				continue
+			}
+			if scrubJSON(e) {
+				scrubbed = true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if scrubJSON(e) {
+				scrubbed = true
+			}
+		}
+	}
+	return scrubbed
+}
@@ -0,0 +1,177 @@
+package gitlab
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	mux, server, _ := setup()
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name": "1.0.0"},{"name": "1.0.1"}]`)
+	})
+
+	dir, err := ioutil.TempDir("", "go-gitlab")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fixture := filepath.Join(dir, "tags.json")
+
+	rec, err := NewRecorder(fixture, RecordMode, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+
+	client := NewClient(&http.Client{Transport: rec}, "secret-token")
+	client.SetBaseURL(server.URL)
+
+	recorded, _, err := client.Tags.ListTags(1, nil)
+	if err != nil {
+		t.Fatalf("Tags.ListTags returned error: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Recorder.Stop returned error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	if strings.Contains(string(data), "secret-token") {
+		t.Errorf("Fixture contains the private token: %s", data)
+	}
+
+	// Close the server to make sure the replay does not hit the network.
+	teardown(server)
+
+	rec, err = NewRecorder(fixture, ReplayMode, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+
+	client = NewClient(&http.Client{Transport: rec}, "")
+	client.SetBaseURL(server.URL)
+
+	replayed, _, err := client.Tags.ListTags(1, nil)
+	if err != nil {
+		t.Fatalf("Tags.ListTags returned error: %v", err)
+	}
+	if !reflect.DeepEqual(recorded, replayed) {
+		t.Errorf("Replayed %+v, want %+v", replayed, recorded)
+	}
+
+	if _, _, err := client.Tags.ListTags(1, nil); err == nil {
+		t.Error("Expected an error when all interactions are replayed")
+	}
+}
+
+func TestRecorderScrubsTokens(t *testing.T) {
+	mux, server, _ := setup()
+
+	mux.HandleFunc("/api/v4/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 1, "token": "runner-secret"}`)
+	})
+
+	dir, err := ioutil.TempDir("", "go-gitlab")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fixture := filepath.Join(dir, "runners.json")
+
+	rec, err := NewRecorder(fixture, RecordMode, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+
+	send := func(rec *Recorder) string {
+		c := &http.Client{Transport: rec}
+		resp, err := c.Post(server.URL+"/api/v4/runners?private_token=query-secret", "application/json",
+			strings.NewReader(`{"token": "registration-secret", "description": "test"}`))
+		if err != nil {
+			t.Fatalf("Sending request returned error: %v", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Reading response returned error: %v", err)
+		}
+		return string(body)
+	}
+
+	send(rec)
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Recorder.Stop returned error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+	for _, secret := range []string{"query-secret", "registration-secret", "runner-secret"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Fixture contains %q: %s", secret, data)
+		}
+	}
+
+	teardown(server)
+
+	rec, err = NewRecorder(fixture, ReplayMode, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+
+	want := `{"id":1,"token":"REDACTED"}`
+	if got := send(rec); got != want {
+		t.Errorf("Replayed %s, want %s", got, want)
+	}
+}
+
+func TestRecorderDoesNotModifyRequest(t *testing.T) {
+	mux, server, _ := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"test"}`)
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	dir, err := ioutil.TempDir("", "go-gitlab")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	rec, err := NewRecorder(filepath.Join(dir, "projects.json"), RecordMode, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", server.URL+"/api/v4/projects", strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	body := req.Body
+
+	resp, err := rec.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Recorder.RoundTrip returned error: %v", err)
+	}
+	resp.Body.Close()
+
+	if req.Body != body {
+		t.Error("Recorder.RoundTrip replaced the body of the request")
+	}
+}
@@ -0,0 +1,120 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// RelatedEpicLinksService handles communication with the related epic links
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/linked_epics.html
+type RelatedEpicLinksService struct {
+	client *Client
+}
+
+// RelatedEpicLink represents a relation between two epics.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/linked_epics.html
+type RelatedEpicLink struct {
+	ID         int        `json:"id"`
+	SourceEpic *Epic      `json:"source_epic"`
+	TargetEpic *Epic      `json:"target_epic"`
+	LinkType   string     `json:"link_type"`
+	CreatedAt  *time.Time `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+}
+
+func (l RelatedEpicLink) String() string {
+	return Stringify(l)
+}
+
+// ListRelatedEpicLinks gets the epics related to a given epic. The
+// RelatedEpicLinkID and LinkType fields of the returned epics describe the
+// relation.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/linked_epics.html#list-linked-epics-from-an-epic
+func (s *RelatedEpicLinksService) ListRelatedEpicLinks(gid interface{}, epicIID int, options ...OptionFunc) ([]*Epic, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/related_epics", pathEscape(group), epicIID)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var es []*Epic
+	resp, err := s.client.Do(req, &es)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return es, resp, err
+}
+
+// CreateRelatedEpicLinkOptions represents the available
+// CreateRelatedEpicLink() options. LinkType is one of "relates_to",
+// "blocks" or "is_blocked_by", and defaults to "relates_to".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/linked_epics.html#create-a-related-epic-link
+type CreateRelatedEpicLinkOptions struct {
+	TargetGroupID *string `url:"target_group_id,omitempty" json:"target_group_id,omitempty"`
+	TargetEpicIID *string `url:"target_epic_iid,omitempty" json:"target_epic_iid,omitempty"`
+	LinkType      *string `url:"link_type,omitempty" json:"link_type,omitempty"`
+}
+
+// CreateRelatedEpicLink creates a relation between two epics. The user must
+// be allowed to update both epics.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/linked_epics.html#create-a-related-epic-link
+func (s *RelatedEpicLinksService) CreateRelatedEpicLink(gid interface{}, epicIID int, opt *CreateRelatedEpicLinkOptions, options ...OptionFunc) (*RelatedEpicLink, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/related_epics", pathEscape(group), epicIID)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(RelatedEpicLink)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, err
+}
+
+// DeleteRelatedEpicLink deletes a relation between two epics.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/linked_epics.html#delete-a-related-epic-link
+func (s *RelatedEpicLinksService) DeleteRelatedEpicLink(gid interface{}, epicIID, relatedEpicLinkID int, options ...OptionFunc) (*RelatedEpicLink, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/related_epics/%d", pathEscape(group), epicIID, relatedEpicLinkID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(RelatedEpicLink)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, err
+}
@@ -0,0 +1,74 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListRelatedEpicLinks(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/2/epics/5/related_epics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 12, "iid": 3, "group_id": 7, "title": "Dependency", "related_epic_link_id": 1, "link_type": "blocks"}]`)
+	})
+
+	epics, _, err := client.RelatedEpicLinks.ListRelatedEpicLinks(2, 5)
+	if err != nil {
+		t.Fatalf("RelatedEpicLinks.ListRelatedEpicLinks returned error: %v", err)
+	}
+
+	want := []*Epic{{ID: 12, IID: 3, GroupID: 7, Title: "Dependency", RelatedEpicLinkID: 1, LinkType: "blocks"}}
+	if !reflect.DeepEqual(want, epics) {
+		t.Errorf("RelatedEpicLinks.ListRelatedEpicLinks returned %+v, want %+v", epics, want)
+	}
+}
+
+func TestCreateRelatedEpicLink(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/2/epics/5/related_epics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"target_group_id":"7","target_epic_iid":"3","link_type":"blocks"}`)
+		fmt.Fprint(w, `{"id": 1, "source_epic": {"id": 10, "iid": 5}, "target_epic": {"id": 12, "iid": 3}, "link_type": "blocks"}`)
+	})
+
+	opt := &CreateRelatedEpicLinkOptions{
+		TargetGroupID: String("7"),
+		TargetEpicIID: String("3"),
+		LinkType:      String("blocks"),
+	}
+	link, _, err := client.RelatedEpicLinks.CreateRelatedEpicLink(2, 5, opt)
+	if err != nil {
+		t.Fatalf("RelatedEpicLinks.CreateRelatedEpicLink returned error: %v", err)
+	}
+
+	want := &RelatedEpicLink{
+		ID:         1,
+		SourceEpic: &Epic{ID: 10, IID: 5},
+		TargetEpic: &Epic{ID: 12, IID: 3},
+		LinkType:   "blocks",
+	}
+	if !reflect.DeepEqual(want, link) {
+		t.Errorf("RelatedEpicLinks.CreateRelatedEpicLink returned %+v, want %+v", link, want)
+	}
+}
+
+func TestDeleteRelatedEpicLink(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/2/epics/5/related_epics/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"id": 1, "link_type": "relates_to"}`)
+	})
+
+	_, _, err := client.RelatedEpicLinks.DeleteRelatedEpicLink(2, 5, 1)
+	if err != nil {
+		t.Fatalf("RelatedEpicLinks.DeleteRelatedEpicLink returned error: %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ReleaseNotesGroup represents a section of generated release notes. A merge
+// request is listed in the first group that has one of its labels.
+type ReleaseNotesGroup struct {
+	Title  string
+	Labels []string
+}
+
+// ReleaseNotesOptions represents the available GenerateReleaseNotes()
+// options.
+type ReleaseNotesOptions struct {
+	// Groups lists the sections of the release notes, in order.
+	Groups []*ReleaseNotesGroup
+
+	// OtherTitle is the title of the section containing all merged merge
+	// requests that don't match any of the groups. Defaults to "Other
+	// changes".
+	OtherTitle string
+
+	// CommitsTitle is the title of the section containing the commits that
+	// are not part of any merged merge request. Defaults to "Commits".
+	CommitsTitle string
+
+	// SkipCommits omits the commits that are not part of any merged merge
+	// request from the release notes.
+	SkipCommits bool
+}
+
+// GenerateReleaseNotes gathers the merge requests and commits between two
+// refs (usually tags) and returns a markdown changelog grouped by merge
+// request labels, which can be used as the description of a release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#compare-branches-tags-or-commits
+func (s *RepositoriesService) GenerateReleaseNotes(pid interface{}, from, to string, opt *ReleaseNotesOptions, options ...OptionFunc) (string, *Response, error) {
+	if opt == nil {
+		opt = new(ReleaseNotesOptions)
+	}
+
+	cmp, resp, err := s.Compare(pid, &CompareOptions{From: String(from), To: String(to)}, options...)
+	if err != nil {
+		return "", resp, err
+	}
+
+	var mrs []*MergeRequest
+	var commits []*Commit
+	seen := make(map[int]bool)
+
+	for _, c := range cmp.Commits {
+		found, resp, err := s.client.Commits.GetMergeRequestsByCommit(pid, c.ID, options...)
+		if err != nil {
+			return "", resp, err
+		}
+
+		merged := false
+		for _, mr := range found {
+			if mr.State != "merged" {
+				continue
+			}
+			merged = true
+			if !seen[mr.IID] {
+				seen[mr.IID] = true
+				mrs = append(mrs, mr)
+			}
+		}
+
+		// Merge commits only add noise when they are not part of a merge request.
+		if !merged && len(c.ParentIDs) < 2 {
+			commits = append(commits, c)
+		}
+	}
+
+	groups := make([][]*MergeRequest, len(opt.Groups)+1)
+	for _, mr := range mrs {
+		i := releaseNotesGroup(opt.Groups, mr)
+		groups[i] = append(groups[i], mr)
+	}
+
+	otherTitle := opt.OtherTitle
+	if otherTitle == "" {
+		otherTitle = "Other changes"
+	}
+	commitsTitle := opt.CommitsTitle
+	if commitsTitle == "" {
+		commitsTitle = "Commits"
+	}
+
+	var b bytes.Buffer
+	for i, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		title := otherTitle
+		if i < len(opt.Groups) {
+			title = opt.Groups[i].Title
+		}
+
+		writeReleaseNotesTitle(&b, title)
+		for _, mr := range group {
+			fmt.Fprintf(&b, "- %s (!%d)", mr.Title, mr.IID)
+			if mr.Author.Username != "" {
+				fmt.Fprintf(&b, " @%s", mr.Author.Username)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if !opt.SkipCommits && len(commits) > 0 {
+		writeReleaseNotesTitle(&b, commitsTitle)
+		for _, c := range commits {
+			fmt.Fprintf(&b, "- %s (%s)\n", c.Title, c.ShortID)
+		}
+	}
+
+	return b.String(), resp, nil
+}
+
+// releaseNotesGroup returns the index of the group the merge request belongs
+// to, or len(groups) if it doesn't match any of them.
+func releaseNotesGroup(groups []*ReleaseNotesGroup, mr *MergeRequest) int {
+	for i, g := range groups {
+		for _, want := range g.Labels {
+			for _, label := range mr.Labels {
+				if label == want {
+					return i
+				}
+			}
+		}
+	}
+	return len(groups)
+}
+
+func writeReleaseNotesTitle(b *bytes.Buffer, title string) {
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "## %s\n\n", title)
+}
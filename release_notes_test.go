@@ -0,0 +1,49 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGenerateReleaseNotes(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/compare?from=v1.0.0&to=v1.1.0")
+		fmt.Fprint(w, `{"commits": [
+			{"id": "a1", "short_id": "a1", "title": "Add feature", "parent_ids": ["p"]},
+			{"id": "b2", "short_id": "b2", "title": "Fix bug", "parent_ids": ["a1"]},
+			{"id": "c3", "short_id": "c3", "title": "Merge branch 'fix'", "parent_ids": ["a1", "b2"]},
+			{"id": "d4", "short_id": "d4", "title": "Bump version", "parent_ids": ["c3"]}
+		]}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/a1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"iid": 1, "title": "New feature", "state": "merged", "labels": ["feature"], "author": {"username": "alice"}}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b2/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"iid": 2, "title": "Bug fix", "state": "merged", "labels": ["bug"]}, {"iid": 3, "title": "Draft", "state": "opened"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/c3/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"iid": 2, "title": "Bug fix", "state": "merged", "labels": ["bug"]}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/d4/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	notes, _, err := client.Repositories.GenerateReleaseNotes(1, "v1.0.0", "v1.1.0", &ReleaseNotesOptions{
+		Groups: []*ReleaseNotesGroup{
+			{Title: "Features", Labels: []string{"feature"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Repositories.GenerateReleaseNotes returned error: %v", err)
+	}
+
+	want := "## Features\n\n- New feature (!1) @alice\n\n## Other changes\n\n- Bug fix (!2)\n\n## Commits\n\n- Bump version (d4)\n"
+	if notes != want {
+		t.Errorf("Repositories.GenerateReleaseNotes returned %q, want %q", notes, want)
+	}
+}
@@ -0,0 +1,509 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxReleaseRedirects is the maximum number of redirects followed when
+// resolving release asset URLs.
+const maxReleaseRedirects = 10
+
+// ReleasesService handles communication with the releases related methods
+// of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/releases/
+type ReleasesService struct {
+	client *Client
+}
+
+// ReleaseAssets represents the assets of a release: the source code
+// archives generated by GitLab and the linked assets.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/
+type ReleaseAssets struct {
+	Count   int                   `json:"count"`
+	Sources []*ReleaseAssetSource `json:"sources"`
+	Links   []*ReleaseLink        `json:"links"`
+}
+
+// ReleaseAssetSource represents a source code archive of a release.
+type ReleaseAssetSource struct {
+	Format string `json:"format"`
+	URL    string `json:"url"`
+}
+
+// ReleaseLinkTypeValue represents the type of a release asset link.
+type ReleaseLinkTypeValue string
+
+// List of available release asset link types
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/links.html#create-a-release-link
+const (
+	OtherLinkType   ReleaseLinkTypeValue = "other"
+	RunbookLinkType ReleaseLinkTypeValue = "runbook"
+	ImageLinkType   ReleaseLinkTypeValue = "image"
+	PackageLinkType ReleaseLinkTypeValue = "package"
+)
+
+// ReleaseLink represents an asset link of a release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/links.html
+type ReleaseLink struct {
+	ID             int                  `json:"id"`
+	Name           string               `json:"name"`
+	URL            string               `json:"url"`
+	DirectAssetURL string               `json:"direct_asset_url"`
+	External       bool                 `json:"external"`
+	LinkType       ReleaseLinkTypeValue `json:"link_type"`
+}
+
+func (l ReleaseLink) String() string {
+	return Stringify(l)
+}
+
+// Release represents a GitLab version release.
+//
+// The release of a Tag only has the TagName and Description set, all other
+// fields are only returned by the Releases API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/releases/
+type Release struct {
+	TagName           string         `json:"tag_name"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description"`
+	DescriptionHTML   string         `json:"description_html"`
+	CreatedAt         *time.Time     `json:"created_at"`
+	ReleasedAt        *time.Time     `json:"released_at"`
+	UpcomingRelease   bool           `json:"upcoming_release"`
+	HistoricalRelease bool           `json:"historical_release"`
+	Milestones        []*Milestone   `json:"milestones"`
+	Assets            *ReleaseAssets `json:"assets"`
+}
+
+func (r Release) String() string {
+	return Stringify(r)
+}
+
+// SplitUpcomingReleases splits a list of releases returned by the Releases API
+// into the releases that are published and the upcoming releases, which have
+// a release date in the future. The order of the releases is kept.
+func SplitUpcomingReleases(releases []*Release) (published, upcoming []*Release) {
+	for _, r := range releases {
+		if r.UpcomingRelease {
+			upcoming = append(upcoming, r)
+		} else {
+			published = append(published, r)
+		}
+	}
+	return published, upcoming
+}
+
+// ListReleasesOptions represents the available ListReleases() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/#list-releases
+type ListReleasesOptions struct {
+	ListOptions
+	OrderBy                *string `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort                   *string `url:"sort,omitempty" json:"sort,omitempty"`
+	IncludeHTMLDescription *bool   `url:"include_html_description,omitempty" json:"include_html_description,omitempty"`
+}
+
+// ListReleases gets a paginated list of the releases of a project, sorted by
+// released_at by default.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/#list-releases
+func (s *ReleasesService) ListReleases(pid interface{}, opt *ListReleasesOptions, options ...OptionFunc) ([]*Release, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rs []*Release
+	resp, err := s.client.Do(req, &rs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rs, resp, err
+}
+
+// ListGroupReleasesOptions represents the available ListGroupReleases()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/group_releases.html#list-group-releases
+type ListGroupReleasesOptions struct {
+	ListOptions
+	Sort   *string `url:"sort,omitempty" json:"sort,omitempty"`
+	Simple *bool   `url:"simple,omitempty" json:"simple,omitempty"`
+}
+
+// ListGroupReleases gets a paginated list of the releases of all projects in
+// a group and its subgroups, sorted by released_at.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/group_releases.html#list-group-releases
+func (s *ReleasesService) ListGroupReleases(gid interface{}, opt *ListGroupReleasesOptions, options ...OptionFunc) ([]*Release, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/releases", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rs []*Release
+	resp, err := s.client.Do(req, &rs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rs, resp, err
+}
+
+// CreateReleaseOptions represents the available CreateRelease() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/#create-a-release
+type CreateReleaseOptions struct {
+	Name        *string    `url:"name,omitempty" json:"name,omitempty"`
+	TagName     *string    `url:"tag_name,omitempty" json:"tag_name,omitempty"`
+	TagMessage  *string    `url:"tag_message,omitempty" json:"tag_message,omitempty"`
+	Description *string    `url:"description,omitempty" json:"description,omitempty"`
+	Ref         *string    `url:"ref,omitempty" json:"ref,omitempty"`
+	Milestones  *[]string  `url:"milestones,omitempty" json:"milestones,omitempty"`
+	ReleasedAt  *time.Time `url:"released_at,omitempty" json:"released_at,omitempty"`
+}
+
+// CreateRelease creates a release. If the tag does not exist yet, it is
+// created from ref, as an annotated tag when a tag message is given. A
+// release date in the future creates an upcoming release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/#create-a-release
+func (s *ReleasesService) CreateRelease(pid interface{}, opt *CreateReleaseOptions, options ...OptionFunc) (*Release, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(Release)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// UpdateReleaseOptions represents the available UpdateRelease() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/#update-a-release
+type UpdateReleaseOptions struct {
+	Name        *string    `url:"name,omitempty" json:"name,omitempty"`
+	Description *string    `url:"description,omitempty" json:"description,omitempty"`
+	Milestones  *[]string  `url:"milestones,omitempty" json:"milestones,omitempty"`
+	ReleasedAt  *time.Time `url:"released_at,omitempty" json:"released_at,omitempty"`
+}
+
+// UpdateRelease updates a release. An empty list of milestones removes all
+// milestones from the release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/#update-a-release
+func (s *ReleasesService) UpdateRelease(pid interface{}, tag string, opt *UpdateReleaseOptions, options ...OptionFunc) (*Release, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases/%s", pathEscape(project), pathEscape(tag))
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(Release)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// GetLatestReleaseAssetURL resolves the asset with the given direct asset path
+// (e.g. "/binaries/linux-amd64") of the latest release of a project to the URL
+// the asset is actually served from. The redirects are followed with the
+// authentication of the client as long as they stay on the GitLab instance,
+// so this works for private projects as well, without leaking the token to
+// external asset hosts.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/#get-the-latest-release
+func (s *ReleasesService) GetLatestReleaseAssetURL(pid interface{}, filepath string, options ...OptionFunc) (string, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return "", nil, err
+	}
+
+	segments := strings.Split(strings.TrimPrefix(filepath, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	u := fmt.Sprintf(
+		"projects/%s/releases/permalink/latest/downloads/%s",
+		pathEscape(project),
+		strings.Join(segments, "/"),
+	)
+
+	req, err := s.client.NewRequest("HEAD", u, nil, options)
+	if err != nil {
+		return "", nil, err
+	}
+	if cancel, ok := req.Context().Value(timeoutCancelKey{}).(context.CancelFunc); ok {
+		defer cancel()
+	}
+
+	// Redirects are followed by hand, so the authentication headers are only
+	// sent to the GitLab instance.
+	httpClient := *s.client.client
+	httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	for i := 0; ; i++ {
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return "", nil, err
+		}
+		resp.Body.Close()
+		response := newResponse(resp)
+
+		location, err := resp.Location()
+		if err == http.ErrNoLocation {
+			if err := CheckResponse(resp); err != nil {
+				return "", response, err
+			}
+			// The asset is served by GitLab itself.
+			return req.URL.String(), response, nil
+		}
+		if err != nil {
+			return "", response, err
+		}
+
+		if location.Host != s.client.baseURL.Host {
+			return location.String(), response, nil
+		}
+		if i == maxReleaseRedirects {
+			return "", response, fmt.Errorf("stopped after %d redirects", maxReleaseRedirects)
+		}
+
+		next := req.Clone(req.Context())
+		next.URL = location
+		next.Host = location.Host
+		req = next
+	}
+}
+
+// CreateReleaseLinkOptions represents the available CreateReleaseLink()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/links.html#create-a-release-link
+type CreateReleaseLinkOptions struct {
+	Name            *string               `url:"name,omitempty" json:"name,omitempty"`
+	URL             *string               `url:"url,omitempty" json:"url,omitempty"`
+	DirectAssetPath *string               `url:"direct_asset_path,omitempty" json:"direct_asset_path,omitempty"`
+	LinkType        *ReleaseLinkTypeValue `url:"link_type,omitempty" json:"link_type,omitempty"`
+}
+
+// CreateReleaseLink creates an asset link for a release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/releases/links.html#create-a-release-link
+func (s *ReleasesService) CreateReleaseLink(pid interface{}, tag string, opt *CreateReleaseLinkOptions, options ...OptionFunc) (*ReleaseLink, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases/%s/assets/links", pathEscape(project), pathEscape(tag))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(ReleaseLink)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, err
+}
+
+// UploadAndLinkAssetOptions represents the available UploadAndLinkAsset()
+// options.
+type UploadAndLinkAssetOptions struct {
+	// Name is the name of the asset link. It defaults to the name of the
+	// uploaded file.
+	Name *string
+
+	// DirectAssetPath is the path of the permanent link to the asset, like
+	// "/binaries/linux-amd64".
+	DirectAssetPath *string
+
+	// LinkType is the type of the asset link, "other" by default.
+	LinkType *ReleaseLinkTypeValue
+}
+
+// UploadAndLinkAsset uploads the local file at path to the project and
+// attaches it to the release as an asset link.
+func (s *ReleasesService) UploadAndLinkAsset(pid interface{}, tag, path string, opt *UploadAndLinkAssetOptions, options ...OptionFunc) (*ReleaseLink, *Response, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	filename := filepath.Base(path)
+	uf, resp, err := s.client.Projects.UploadFile(pid, f, filename, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// The URL of an upload is relative to the web URL of the project.
+	p, resp, err := s.client.Projects.GetProject(pid, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	lo := &CreateReleaseLinkOptions{
+		Name: &filename,
+		URL:  String(strings.TrimSuffix(p.WebURL, "/") + uf.URL),
+	}
+	if opt != nil {
+		if opt.Name != nil {
+			lo.Name = opt.Name
+		}
+		lo.DirectAssetPath = opt.DirectAssetPath
+		lo.LinkType = opt.LinkType
+	}
+
+	return s.CreateReleaseLink(pid, tag, lo, options...)
+}
+
+// DownloadReleaseAssetSource streams the source code archive of a release to
+// w. The format is one of the archive formats supported by GitLab, like
+// "zip" or "tar.gz".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
+func (s *ReleasesService) DownloadReleaseAssetSource(pid interface{}, tag, format string, w io.Writer, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/archive.%s", pathEscape(project), format)
+
+	req, err := s.client.NewRequest("GET", u, &ArchiveOptions{SHA: &tag}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// DownloadReleaseAssetLink streams the asset a release link points to to w,
+// using the direct asset URL of the link when it has one. The request is
+// authenticated as long as it is sent to the GitLab instance, so assets of
+// private projects can be downloaded as well, but the token is never sent to
+// external asset hosts, not even when GitLab redirects to them.
+func (s *ReleasesService) DownloadReleaseAssetLink(link *ReleaseLink, w io.Writer, options ...OptionFunc) (*Response, error) {
+	target := link.DirectAssetURL
+	if target == "" {
+		target = link.URL
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", "", nil, options)
+	if err != nil {
+		return nil, err
+	}
+	if cancel, ok := req.Context().Value(timeoutCancelKey{}).(context.CancelFunc); ok {
+		defer cancel()
+	}
+	req.URL = u
+	req.Host = u.Host
+	req.Header.Del("Accept")
+	if u.Host != s.client.baseURL.Host {
+		removeAuthHeaders(req.Header)
+	}
+
+	httpClient := *s.client.client
+	httpClient.CheckRedirect = func(next *http.Request, via []*http.Request) error {
+		if len(via) > maxReleaseRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxReleaseRedirects)
+		}
+		if next.URL.Host != s.client.baseURL.Host {
+			removeAuthHeaders(next.Header)
+		}
+		return nil
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := newResponse(resp)
+	if err := CheckResponse(resp); err != nil {
+		return response, err
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return response, err
+}
+
+// removeAuthHeaders removes the headers used to authenticate with GitLab,
+// including the Sudo header.
+func removeAuthHeaders(h http.Header) {
+	h.Del("PRIVATE-TOKEN")
+	h.Del("JOB-TOKEN")
+	h.Del("Authorization")
+	h.Del("Sudo")
+}
@@ -0,0 +1,368 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetLatestReleaseAssetURL(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	client.token = "secret"
+
+	mux.HandleFunc("/api/v4/projects/1/releases/permalink/latest/downloads/bin/linux-amd64", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "HEAD")
+		http.Redirect(w, r, "/api/v4/projects/1/releases/v1.2.0/downloads/bin/linux-amd64", http.StatusFound)
+	})
+	mux.HandleFunc("/api/v4/projects/1/releases/v1.2.0/downloads/bin/linux-amd64", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "HEAD")
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "secret" {
+			t.Errorf("Redirected request PRIVATE-TOKEN header is %q, want %q", got, "secret")
+		}
+		http.Redirect(w, r, "https://downloads.example.com/app/v1.2.0/linux-amd64", http.StatusFound)
+	})
+
+	u, _, err := client.Releases.GetLatestReleaseAssetURL(1, "/bin/linux-amd64")
+	if err != nil {
+		t.Fatalf("Releases.GetLatestReleaseAssetURL returned error: %v", err)
+	}
+
+	want := "https://downloads.example.com/app/v1.2.0/linux-amd64"
+	if u != want {
+		t.Errorf("Releases.GetLatestReleaseAssetURL returned %q, want %q", u, want)
+	}
+}
+
+func TestGetLatestReleaseAssetURLServedByGitLab(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/permalink/latest/downloads/app.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/group/project/-/package_files/12/download", http.StatusFound)
+	})
+	mux.HandleFunc("/group/project/-/package_files/12/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "HEAD")
+	})
+
+	u, _, err := client.Releases.GetLatestReleaseAssetURL(1, "app.tar.gz")
+	if err != nil {
+		t.Fatalf("Releases.GetLatestReleaseAssetURL returned error: %v", err)
+	}
+
+	want := server.URL + "/group/project/-/package_files/12/download"
+	if u != want {
+		t.Errorf("Releases.GetLatestReleaseAssetURL returned %q, want %q", u, want)
+	}
+}
+
+func TestGetLatestReleaseAssetURLNotFound(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/permalink/latest/downloads/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.Releases.GetLatestReleaseAssetURL(1, "missing")
+	if !IsNotFound(err) {
+		t.Errorf("Releases.GetLatestReleaseAssetURL returned %v, want a not found error", err)
+	}
+}
+
+func TestListReleases(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/releases?include_html_description=false&order_by=released_at&sort=asc")
+		fmt.Fprint(w, `[{"tag_name": "v1.0.0", "name": "v1.0.0"}, {"tag_name": "v1.1.0", "name": "v1.1.0"}]`)
+	})
+
+	opt := &ListReleasesOptions{
+		OrderBy:                String("released_at"),
+		Sort:                   String("asc"),
+		IncludeHTMLDescription: Bool(false),
+	}
+
+	releases, _, err := client.Releases.ListReleases(1, opt)
+	if err != nil {
+		t.Fatalf("Releases.ListReleases returned error: %v", err)
+	}
+
+	want := []*Release{{TagName: "v1.0.0", Name: "v1.0.0"}, {TagName: "v1.1.0", Name: "v1.1.0"}}
+	if !reflect.DeepEqual(want, releases) {
+		t.Errorf("Releases.ListReleases returned %+v, want %+v", releases, want)
+	}
+}
+
+func TestSplitUpcomingReleases(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"tag_name": "v2.0.0", "name": "v2.0.0", "released_at": "2030-01-01T00:00:00Z", "upcoming_release": true},
+			{"tag_name": "v1.1.0", "name": "v1.1.0", "released_at": "2020-06-01T00:00:00Z", "upcoming_release": false},
+			{"tag_name": "v1.0.0", "name": "v1.0.0", "released_at": "2019-01-01T00:00:00Z", "upcoming_release": false, "historical_release": true}
+		]`)
+	})
+
+	releases, _, err := client.Releases.ListReleases(1, nil)
+	if err != nil {
+		t.Fatalf("Releases.ListReleases returned error: %v", err)
+	}
+	if len(releases) != 3 || !releases[2].HistoricalRelease {
+		t.Fatalf("Releases.ListReleases returned %+v", releases)
+	}
+
+	published, upcoming := SplitUpcomingReleases(releases)
+
+	if want := []*Release{releases[1], releases[2]}; !reflect.DeepEqual(want, published) {
+		t.Errorf("SplitUpcomingReleases returned published %+v, want %+v", published, want)
+	}
+	if want := []*Release{releases[0]}; !reflect.DeepEqual(want, upcoming) {
+		t.Errorf("SplitUpcomingReleases returned upcoming %+v, want %+v", upcoming, want)
+	}
+}
+
+func TestListGroupReleases(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/my-group/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/my-group/releases?page=2&simple=true&sort=desc")
+		fmt.Fprint(w, `[{"tag_name": "v1.1.0", "name": "app v1.1.0"}, {"tag_name": "v3.0.0", "name": "lib v3.0.0"}]`)
+	})
+
+	opt := &ListGroupReleasesOptions{
+		ListOptions: ListOptions{Page: 2},
+		Sort:        String("desc"),
+		Simple:      Bool(true),
+	}
+
+	releases, _, err := client.Releases.ListGroupReleases("my-group", opt)
+	if err != nil {
+		t.Fatalf("Releases.ListGroupReleases returned error: %v", err)
+	}
+
+	want := []*Release{{TagName: "v1.1.0", Name: "app v1.1.0"}, {TagName: "v3.0.0", Name: "lib v3.0.0"}}
+	if !reflect.DeepEqual(want, releases) {
+		t.Errorf("Releases.ListGroupReleases returned %+v, want %+v", releases, want)
+	}
+}
+
+func TestCreateReleaseScheduled(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"v1.2.0","tag_name":"v1.2.0","tag_message":"Version 1.2.0","ref":"main","milestones":["1.2"],"released_at":"2030-01-01T00:00:00Z"}`)
+		fmt.Fprint(w, `{"tag_name": "v1.2.0", "name": "v1.2.0", "upcoming_release": true, "milestones": [{"id": 1, "title": "1.2"}]}`)
+	})
+
+	releasedAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opt := &CreateReleaseOptions{
+		Name:       String("v1.2.0"),
+		TagName:    String("v1.2.0"),
+		TagMessage: String("Version 1.2.0"),
+		Ref:        String("main"),
+		Milestones: &[]string{"1.2"},
+		ReleasedAt: &releasedAt,
+	}
+
+	release, _, err := client.Releases.CreateRelease(1, opt)
+	if err != nil {
+		t.Fatalf("Releases.CreateRelease returned error: %v", err)
+	}
+
+	want := &Release{
+		TagName:         "v1.2.0",
+		Name:            "v1.2.0",
+		UpcomingRelease: true,
+		Milestones:      []*Milestone{{ID: 1, Title: "1.2"}},
+	}
+	if !reflect.DeepEqual(want, release) {
+		t.Errorf("Releases.CreateRelease returned %+v, want %+v", release, want)
+	}
+}
+
+func TestUpdateReleaseRemoveMilestones(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v1.2.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"milestones":[]}`)
+		fmt.Fprint(w, `{"tag_name": "v1.2.0", "name": "v1.2.0", "milestones": []}`)
+	})
+
+	release, _, err := client.Releases.UpdateRelease(1, "v1.2.0", &UpdateReleaseOptions{Milestones: &[]string{}})
+	if err != nil {
+		t.Fatalf("Releases.UpdateRelease returned error: %v", err)
+	}
+
+	want := &Release{TagName: "v1.2.0", Name: "v1.2.0", Milestones: []*Milestone{}}
+	if !reflect.DeepEqual(want, release) {
+		t.Errorf("Releases.UpdateRelease returned %+v, want %+v", release, want)
+	}
+}
+
+func TestDownloadReleaseAssetSource(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/archive.tar.gz?sha=v1.2.0")
+		fmt.Fprint(w, "archive content")
+	})
+
+	var b bytes.Buffer
+	_, err := client.Releases.DownloadReleaseAssetSource(1, "v1.2.0", "tar.gz", &b)
+	if err != nil {
+		t.Fatalf("Releases.DownloadReleaseAssetSource returned error: %v", err)
+	}
+
+	if b.String() != "archive content" {
+		t.Errorf("Releases.DownloadReleaseAssetSource wrote %q, want %q", b.String(), "archive content")
+	}
+}
+
+func TestDownloadReleaseAssetLink(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	client.token = "secret"
+	client.sudo = "root"
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "" {
+			t.Errorf("Asset host received PRIVATE-TOKEN header %q", got)
+		}
+		if got := r.Header.Get("Sudo"); got != "" {
+			t.Errorf("Asset host received Sudo header %q", got)
+		}
+		fmt.Fprint(w, "asset content")
+	}))
+	defer storage.Close()
+
+	mux.HandleFunc("/group/project/-/releases/v1.2.0/downloads/bin/app", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("PRIVATE-TOKEN"); got != "secret" {
+			t.Errorf("Request PRIVATE-TOKEN header is %q, want %q", got, "secret")
+		}
+		http.Redirect(w, r, storage.URL+"/objects/app", http.StatusFound)
+	})
+
+	link := &ReleaseLink{
+		Name:           "app",
+		URL:            storage.URL + "/objects/app",
+		DirectAssetURL: server.URL + "/group/project/-/releases/v1.2.0/downloads/bin/app",
+	}
+
+	var b bytes.Buffer
+	_, err := client.Releases.DownloadReleaseAssetLink(link, &b)
+	if err != nil {
+		t.Fatalf("Releases.DownloadReleaseAssetLink returned error: %v", err)
+	}
+
+	if b.String() != "asset content" {
+		t.Errorf("Releases.DownloadReleaseAssetLink wrote %q, want %q", b.String(), "asset content")
+	}
+}
+
+func TestUploadAndLinkAsset(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	dir, err := ioutil.TempDir("", "go-gitlab")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "app-linux-amd64")
+	if err := ioutil.WriteFile(path, []byte("binary"), 0600); err != nil {
+		t.Fatalf("Failed to write asset: %v", err)
+	}
+
+	mux.HandleFunc("/api/v4/projects/1/uploads", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		_, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Upload request has no file: %v", err)
+		}
+		if header.Filename != "app-linux-amd64" {
+			t.Errorf("Uploaded filename is %q, want %q", header.Filename, "app-linux-amd64")
+		}
+		fmt.Fprint(w, `{"alt": "app-linux-amd64", "url": "/uploads/66dbcd21ec5d24ed6ea225176098d52b/app-linux-amd64"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "web_url": "https://gitlab.example.com/group/project"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/releases/v1.2.0/assets/links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Linux binary","url":"https://gitlab.example.com/group/project/uploads/66dbcd21ec5d24ed6ea225176098d52b/app-linux-amd64","direct_asset_path":"/binaries/linux-amd64","link_type":"package"}`)
+		fmt.Fprint(w, `{
+			"id": 2,
+			"name": "Linux binary",
+			"url": "https://gitlab.example.com/group/project/uploads/66dbcd21ec5d24ed6ea225176098d52b/app-linux-amd64",
+			"direct_asset_url": "https://gitlab.example.com/group/project/-/releases/v1.2.0/downloads/binaries/linux-amd64",
+			"link_type": "package"
+		}`)
+	})
+
+	opt := &UploadAndLinkAssetOptions{
+		Name:            String("Linux binary"),
+		DirectAssetPath: String("/binaries/linux-amd64"),
+		LinkType:        ReleaseLinkType(PackageLinkType),
+	}
+	link, _, err := client.Releases.UploadAndLinkAsset(1, "v1.2.0", path, opt)
+	if err != nil {
+		t.Fatalf("Releases.UploadAndLinkAsset returned error: %v", err)
+	}
+
+	want := &ReleaseLink{
+		ID:             2,
+		Name:           "Linux binary",
+		URL:            "https://gitlab.example.com/group/project/uploads/66dbcd21ec5d24ed6ea225176098d52b/app-linux-amd64",
+		DirectAssetURL: "https://gitlab.example.com/group/project/-/releases/v1.2.0/downloads/binaries/linux-amd64",
+		LinkType:       PackageLinkType,
+	}
+	if !reflect.DeepEqual(want, link) {
+		t.Errorf("Releases.UploadAndLinkAsset returned %+v, want %+v", link, want)
+	}
+}
+
+func TestUpdateReleaseDescriptionOnly(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v1.2.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"description":"Fixed release notes"}`)
+		fmt.Fprint(w, `{"tag_name": "v1.2.0", "name": "Version 1.2.0", "description": "Fixed release notes"}`)
+	})
+
+	opt := &UpdateReleaseOptions{Description: String("Fixed release notes")}
+	release, _, err := client.Releases.UpdateRelease(1, "v1.2.0", opt)
+	if err != nil {
+		t.Fatalf("Releases.UpdateRelease returned error: %v", err)
+	}
+
+	want := &Release{TagName: "v1.2.0", Name: "Version 1.2.0", Description: "Fixed release notes"}
+	if !reflect.DeepEqual(want, release) {
+		t.Errorf("Releases.UpdateRelease returned %+v, want %+v", release, want)
+	}
+}
@@ -19,7 +19,7 @@ package gitlab
 import (
 	"bytes"
 	"fmt"
-	"net/url"
+	"time"
 )
 
 // RepositoriesService handles communication with the repositories related
@@ -65,7 +65,7 @@ func (s *RepositoriesService) ListTree(pid interface{}, opt *ListTreeOptions, op
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/tree", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/tree", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -91,7 +91,7 @@ func (s *RepositoriesService) Blob(pid interface{}, sha string, options ...Optio
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/blobs/%s", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/blobs/%s", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -116,7 +116,7 @@ func (s *RepositoriesService) RawBlobContent(pid interface{}, sha string, option
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/blobs/%s/raw", url.QueryEscape(project), sha)
+	u := fmt.Sprintf("projects/%s/repository/blobs/%s/raw", pathEscape(project), sha)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -149,7 +149,7 @@ func (s *RepositoriesService) Archive(pid interface{}, opt *ArchiveOptions, opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/archive", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/archive", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -189,6 +189,7 @@ type CompareOptions struct {
 	From     *string `url:"from,omitempty" json:"from,omitempty"`
 	To       *string `url:"to,omitempty" json:"to,omitempty"`
 	Straight *bool   `url:"straight,omitempty" json:"straight,omitempty"`
+	Unidiff  *bool   `url:"unidiff,omitempty" json:"unidiff,omitempty"`
 }
 
 // Compare compares branches, tags or commits.
@@ -200,7 +201,7 @@ func (s *RepositoriesService) Compare(pid interface{}, opt *CompareOptions, opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/compare", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/compare", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -244,7 +245,7 @@ func (s *RepositoriesService) Contributors(pid interface{}, opt *ListContributor
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/contributors", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/contributors", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -278,7 +279,7 @@ func (s *RepositoriesService) MergeBase(pid interface{}, opt *MergeBaseOptions,
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/merge_base", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/merge_base", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -293,3 +294,91 @@ func (s *RepositoriesService) MergeBase(pid interface{}, opt *MergeBaseOptions,
 
 	return c, resp, err
 }
+
+// ChangelogData represents the changelog data generated by GitLab.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#generate-changelog-data
+type ChangelogData struct {
+	Notes string `json:"notes"`
+}
+
+func (c ChangelogData) String() string {
+	return Stringify(c)
+}
+
+// GenerateChangelogDataOptions represents the available
+// GenerateChangelogData() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#generate-changelog-data
+type GenerateChangelogDataOptions struct {
+	Version    *string    `url:"version,omitempty" json:"version,omitempty"`
+	ConfigFile *string    `url:"config_file,omitempty" json:"config_file,omitempty"`
+	Date       *time.Time `url:"date,omitempty" json:"date,omitempty"`
+	From       *string    `url:"from,omitempty" json:"from,omitempty"`
+	To         *string    `url:"to,omitempty" json:"to,omitempty"`
+	Trailer    *string    `url:"trailer,omitempty" json:"trailer,omitempty"`
+}
+
+// GenerateChangelogData generates the changelog data for a version, without
+// committing it to a changelog file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#generate-changelog-data
+func (s *RepositoriesService) GenerateChangelogData(pid interface{}, opt *GenerateChangelogDataOptions, options ...OptionFunc) (*ChangelogData, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/changelog", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cd := new(ChangelogData)
+	resp, err := s.client.Do(req, cd)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cd, resp, err
+}
+
+// AddChangelogOptions represents the available AddChangelog() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#add-changelog-data-to-a-changelog-file
+type AddChangelogOptions struct {
+	Version    *string    `url:"version,omitempty" json:"version,omitempty"`
+	Branch     *string    `url:"branch,omitempty" json:"branch,omitempty"`
+	ConfigFile *string    `url:"config_file,omitempty" json:"config_file,omitempty"`
+	Date       *time.Time `url:"date,omitempty" json:"date,omitempty"`
+	File       *string    `url:"file,omitempty" json:"file,omitempty"`
+	From       *string    `url:"from,omitempty" json:"from,omitempty"`
+	Message    *string    `url:"message,omitempty" json:"message,omitempty"`
+	To         *string    `url:"to,omitempty" json:"to,omitempty"`
+	Trailer    *string    `url:"trailer,omitempty" json:"trailer,omitempty"`
+}
+
+// AddChangelog generates the changelog data for a version and commits it to
+// a changelog file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#add-changelog-data-to-a-changelog-file
+func (s *RepositoriesService) AddChangelog(pid interface{}, opt *AddChangelogOptions, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/changelog", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
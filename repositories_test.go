@@ -0,0 +1,55 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGenerateChangelogData(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/changelog?from=v1.0.0&to=v1.1.0&trailer=Changelog&version=1.1.0")
+		fmt.Fprint(w, `{"notes": "## 1.1.0 (2021-11-09)\n\n### Features\n"}`)
+	})
+
+	opt := &GenerateChangelogDataOptions{
+		Version: String("1.1.0"),
+		From:    String("v1.0.0"),
+		To:      String("v1.1.0"),
+		Trailer: String("Changelog"),
+	}
+	data, _, err := client.Repositories.GenerateChangelogData(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.GenerateChangelogData returned error: %v", err)
+	}
+
+	want := "## 1.1.0 (2021-11-09)\n\n### Features\n"
+	if data.Notes != want {
+		t.Errorf("Repositories.GenerateChangelogData returned %q, want %q", data.Notes, want)
+	}
+}
+
+func TestAddChangelog(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"version":"1.1.0","branch":"main","file":"CHANGELOG.md","message":"Add changelog for 1.1.0"}`)
+	})
+
+	opt := &AddChangelogOptions{
+		Version: String("1.1.0"),
+		Branch:  String("main"),
+		File:    String("CHANGELOG.md"),
+		Message: String("Add changelog for 1.1.0"),
+	}
+	_, err := client.Repositories.AddChangelog(1, opt)
+	if err != nil {
+		t.Fatalf("Repositories.AddChangelog returned error: %v", err)
+	}
+}
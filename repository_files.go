@@ -69,7 +69,7 @@ func (s *RepositoryFilesService) GetFile(pid interface{}, fileName string, opt *
 	}
 	u := fmt.Sprintf(
 		"projects/%s/repository/files/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		url.PathEscape(fileName),
 	)
 
@@ -107,7 +107,7 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 	}
 	u := fmt.Sprintf(
 		"projects/%s/repository/files/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		url.PathEscape(fileName),
 	)
 
@@ -146,9 +146,15 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 // https://docs.gitlab.com/ce/api/repository_files.html#get-raw-file-from-repository
 type GetRawFileOptions struct {
 	Ref *string `url:"ref,omitempty" json:"ref,omitempty"`
+
+	// ResolveLFS makes GetRawFile return the content of the Git LFS object
+	// instead of the pointer file, when the file is tracked by Git LFS. Use
+	// ParseLFSPointer to only detect pointer files.
+	ResolveLFS *bool `url:"-" json:"-"`
 }
 
-// GetRawFile allows you to receive the raw file in repository.
+// GetRawFile allows you to receive the raw file in repository. Files tracked
+// by Git LFS are returned as pointer files, unless ResolveLFS is set.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#get-raw-file-from-repository
@@ -159,7 +165,7 @@ func (s *RepositoryFilesService) GetRawFile(pid interface{}, fileName string, op
 	}
 	u := fmt.Sprintf(
 		"projects/%s/repository/files/%s/raw",
-		url.QueryEscape(project),
+		pathEscape(project),
 		url.PathEscape(fileName),
 	)
 
@@ -174,6 +180,16 @@ func (s *RepositoryFilesService) GetRawFile(pid interface{}, fileName string, op
 		return nil, resp, err
 	}
 
+	if opt != nil && opt.ResolveLFS != nil && *opt.ResolveLFS {
+		if pointer, ok := ParseLFSPointer(f.Bytes()); ok {
+			f.Reset()
+			resp, err = s.DownloadLFSObject(pid, pointer, &f, options...)
+			if err != nil {
+				return nil, resp, err
+			}
+		}
+	}
+
 	return f.Bytes(), resp, err
 }
 
@@ -213,7 +229,7 @@ func (s *RepositoryFilesService) CreateFile(pid interface{}, fileName string, op
 	}
 	u := fmt.Sprintf(
 		"projects/%s/repository/files/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		url.PathEscape(fileName),
 	)
 
@@ -256,7 +272,7 @@ func (s *RepositoryFilesService) UpdateFile(pid interface{}, fileName string, op
 	}
 	u := fmt.Sprintf(
 		"projects/%s/repository/files/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		url.PathEscape(fileName),
 	)
 
@@ -296,7 +312,7 @@ func (s *RepositoryFilesService) DeleteFile(pid interface{}, fileName string, op
 	}
 	u := fmt.Sprintf(
 		"projects/%s/repository/files/%s",
-		url.QueryEscape(project),
+		pathEscape(project),
 		url.PathEscape(fileName),
 	)
 
@@ -0,0 +1,277 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// RepositoryStorageMovesService handles communication with the repository
+// storage moves related methods of the GitLab API. All methods are only
+// available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html
+type RepositoryStorageMovesService struct {
+	client *Client
+}
+
+// RepositoryStorageMove represents the move of a repository to another
+// repository storage. Depending on the kind of repository that is moved,
+// either Project, Group or Snippet is set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html
+type RepositoryStorageMove struct {
+	ID                     int                 `json:"id"`
+	CreatedAt              *time.Time          `json:"created_at"`
+	State                  string              `json:"state"`
+	SourceStorageName      string              `json:"source_storage_name"`
+	DestinationStorageName string              `json:"destination_storage_name"`
+	Project                *RepositoryMoveItem `json:"project"`
+	Group                  *RepositoryMoveItem `json:"group"`
+	Snippet                *RepositoryMoveItem `json:"snippet"`
+}
+
+func (m RepositoryStorageMove) String() string {
+	return Stringify(m)
+}
+
+// RepositoryMoveItem represents the project, group or snippet whose
+// repository is moved.
+type RepositoryMoveItem struct {
+	ID                int        `json:"id"`
+	Name              string     `json:"name"`
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	NameWithNamespace string     `json:"name_with_namespace"`
+	Path              string     `json:"path"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+	WebURL            string     `json:"web_url"`
+	CreatedAt         *time.Time `json:"created_at"`
+}
+
+// ListRepositoryStorageMovesOptions represents the available options of the
+// methods listing repository storage moves.
+type ListRepositoryStorageMovesOptions ListOptions
+
+// ScheduleStorageMoveOptions represents the available options of the
+// methods scheduling the repository storage move of a single project, group
+// or snippet. GitLab picks a destination storage if none is given.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+type ScheduleStorageMoveOptions struct {
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ScheduleAllStorageMovesOptions represents the available options of the
+// methods scheduling the repository storage moves of all repositories on a
+// storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+type ScheduleAllStorageMovesOptions struct {
+	SourceStorageName      *string `url:"source_storage_name,omitempty" json:"source_storage_name,omitempty"`
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ListAllProjectStorageMoves gets the repository storage moves of all
+// projects.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html#retrieve-all-project-repository-storage-moves
+func (s *RepositoryStorageMovesService) ListAllProjectStorageMoves(opt *ListRepositoryStorageMovesOptions, options ...OptionFunc) ([]*RepositoryStorageMove, *Response, error) {
+	return s.list("project_repository_storage_moves", opt, options)
+}
+
+// ListProjectStorageMoves gets the repository storage moves of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html#retrieve-all-repository-storage-moves-for-a-project
+func (s *RepositoryStorageMovesService) ListProjectStorageMoves(pid interface{}, opt *ListRepositoryStorageMovesOptions, options ...OptionFunc) ([]*RepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves", pathEscape(project))
+
+	return s.list(u, opt, options)
+}
+
+// GetProjectStorageMove gets a single project repository storage move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html#get-a-single-project-repository-storage-move
+func (s *RepositoryStorageMovesService) GetProjectStorageMove(move int, options ...OptionFunc) (*RepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("project_repository_storage_moves/%d", move)
+
+	return s.do("GET", u, nil, options)
+}
+
+// ScheduleProjectStorageMove schedules the move of the repository of a
+// project to another storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+func (s *RepositoryStorageMovesService) ScheduleProjectStorageMove(pid interface{}, opt *ScheduleStorageMoveOptions, options ...OptionFunc) (*RepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves", pathEscape(project))
+
+	return s.do("POST", u, opt, options)
+}
+
+// ScheduleAllProjectStorageMoves schedules the move of the repositories of
+// all projects on a storage to another storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+func (s *RepositoryStorageMovesService) ScheduleAllProjectStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...OptionFunc) (*Response, error) {
+	return s.scheduleAll("project_repository_storage_moves", opt, options)
+}
+
+// ListAllGroupStorageMoves gets the repository storage moves of all groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#retrieve-all-group-repository-storage-moves
+func (s *RepositoryStorageMovesService) ListAllGroupStorageMoves(opt *ListRepositoryStorageMovesOptions, options ...OptionFunc) ([]*RepositoryStorageMove, *Response, error) {
+	return s.list("group_repository_storage_moves", opt, options)
+}
+
+// ListGroupStorageMoves gets the repository storage moves of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#retrieve-all-repository-storage-moves-for-a-single-group
+func (s *RepositoryStorageMovesService) ListGroupStorageMoves(gid interface{}, opt *ListRepositoryStorageMovesOptions, options ...OptionFunc) ([]*RepositoryStorageMove, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/repository_storage_moves", pathEscape(group))
+
+	return s.list(u, opt, options)
+}
+
+// GetGroupStorageMove gets a single group repository storage move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#get-a-single-group-repository-storage-move
+func (s *RepositoryStorageMovesService) GetGroupStorageMove(move int, options ...OptionFunc) (*RepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("group_repository_storage_moves/%d", move)
+
+	return s.do("GET", u, nil, options)
+}
+
+// ScheduleGroupStorageMove schedules the move of the repositories of a group
+// (like its wiki) to another storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-group
+func (s *RepositoryStorageMovesService) ScheduleGroupStorageMove(gid interface{}, opt *ScheduleStorageMoveOptions, options ...OptionFunc) (*RepositoryStorageMove, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/repository_storage_moves", pathEscape(group))
+
+	return s.do("POST", u, opt, options)
+}
+
+// ScheduleAllGroupStorageMoves schedules the move of the repositories of all
+// groups on a storage to another storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#schedule-repository-storage-moves-for-all-groups-on-a-storage-shard
+func (s *RepositoryStorageMovesService) ScheduleAllGroupStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...OptionFunc) (*Response, error) {
+	return s.scheduleAll("group_repository_storage_moves", opt, options)
+}
+
+// ListAllSnippetStorageMoves gets the repository storage moves of all
+// snippets.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippet_repository_storage_moves.html#retrieve-all-snippet-repository-storage-moves
+func (s *RepositoryStorageMovesService) ListAllSnippetStorageMoves(opt *ListRepositoryStorageMovesOptions, options ...OptionFunc) ([]*RepositoryStorageMove, *Response, error) {
+	return s.list("snippet_repository_storage_moves", opt, options)
+}
+
+// ListSnippetStorageMoves gets the repository storage moves of a snippet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippet_repository_storage_moves.html#retrieve-all-repository-storage-moves-for-a-snippet
+func (s *RepositoryStorageMovesService) ListSnippetStorageMoves(snippet int, opt *ListRepositoryStorageMovesOptions, options ...OptionFunc) ([]*RepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("snippets/%d/repository_storage_moves", snippet)
+
+	return s.list(u, opt, options)
+}
+
+// GetSnippetStorageMove gets a single snippet repository storage move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippet_repository_storage_moves.html#get-a-single-snippet-repository-storage-move
+func (s *RepositoryStorageMovesService) GetSnippetStorageMove(move int, options ...OptionFunc) (*RepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("snippet_repository_storage_moves/%d", move)
+
+	return s.do("GET", u, nil, options)
+}
+
+// ScheduleSnippetStorageMove schedules the move of the repository of a
+// snippet to another storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippet_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-snippet
+func (s *RepositoryStorageMovesService) ScheduleSnippetStorageMove(snippet int, opt *ScheduleStorageMoveOptions, options ...OptionFunc) (*RepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("snippets/%d/repository_storage_moves", snippet)
+
+	return s.do("POST", u, opt, options)
+}
+
+// ScheduleAllSnippetStorageMoves schedules the move of the repositories of
+// all snippets on a storage to another storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippet_repository_storage_moves.html#schedule-repository-storage-moves-for-all-snippets-on-a-storage-shard
+func (s *RepositoryStorageMovesService) ScheduleAllSnippetStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...OptionFunc) (*Response, error) {
+	return s.scheduleAll("snippet_repository_storage_moves", opt, options)
+}
+
+func (s *RepositoryStorageMovesService) list(u string, opt *ListRepositoryStorageMovesOptions, options []OptionFunc) ([]*RepositoryStorageMove, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ms []*RepositoryStorageMove
+	resp, err := s.client.Do(req, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
+
+func (s *RepositoryStorageMovesService) do(method, u string, opt interface{}, options []OptionFunc) (*RepositoryStorageMove, *Response, error) {
+	req, err := s.client.NewRequest(method, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(RepositoryStorageMove)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+func (s *RepositoryStorageMovesService) scheduleAll(u string, opt *ScheduleAllStorageMovesOptions, options []OptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
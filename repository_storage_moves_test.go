@@ -0,0 +1,81 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectStorageMoves(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository_storage_moves?page=2")
+		fmt.Fprint(w, `[{"id": 1, "state": "scheduled", "source_storage_name": "default", "destination_storage_name": "storage2", "project": {"id": 1, "name": "project1"}}]`)
+	})
+
+	moves, _, err := client.RepositoryStorageMoves.ListProjectStorageMoves(1, &ListRepositoryStorageMovesOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("RepositoryStorageMoves.ListProjectStorageMoves returned error: %v", err)
+	}
+
+	want := []*RepositoryStorageMove{{
+		ID:                     1,
+		State:                  "scheduled",
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+		Project:                &RepositoryMoveItem{ID: 1, Name: "project1"},
+	}}
+	if !reflect.DeepEqual(want, moves) {
+		t.Errorf("RepositoryStorageMoves.ListProjectStorageMoves returned %+v, want %+v", moves, want)
+	}
+}
+
+func TestScheduleGroupStorageMove(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/2/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"destination_storage_name":"storage2"}`)
+		fmt.Fprint(w, `{"id": 3, "state": "scheduled", "destination_storage_name": "storage2", "group": {"id": 2, "name": "group1"}}`)
+	})
+
+	move, _, err := client.RepositoryStorageMoves.ScheduleGroupStorageMove(2, &ScheduleStorageMoveOptions{DestinationStorageName: String("storage2")})
+	if err != nil {
+		t.Fatalf("RepositoryStorageMoves.ScheduleGroupStorageMove returned error: %v", err)
+	}
+
+	want := &RepositoryStorageMove{
+		ID:                     3,
+		State:                  "scheduled",
+		DestinationStorageName: "storage2",
+		Group:                  &RepositoryMoveItem{ID: 2, Name: "group1"},
+	}
+	if !reflect.DeepEqual(want, move) {
+		t.Errorf("RepositoryStorageMoves.ScheduleGroupStorageMove returned %+v, want %+v", move, want)
+	}
+}
+
+func TestScheduleAllSnippetStorageMoves(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippet_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"source_storage_name":"default","destination_storage_name":"storage2"}`)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message": "202 Accepted"}`)
+	})
+
+	_, err := client.RepositoryStorageMoves.ScheduleAllSnippetStorageMoves(&ScheduleAllStorageMovesOptions{
+		SourceStorageName:      String("default"),
+		DestinationStorageName: String("storage2"),
+	})
+	if err != nil {
+		t.Fatalf("RepositoryStorageMoves.ScheduleAllSnippetStorageMoves returned error: %v", err)
+	}
+}
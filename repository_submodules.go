@@ -0,0 +1,50 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// RepositorySubmodulesService handles communication with the repository
+// submodules related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/repository_submodules.html
+type RepositorySubmodulesService struct {
+	client *Client
+}
+
+// UpdateSubmoduleOptions represents the available UpdateSubmodule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repository_submodules.html#update-existing-submodule-reference-in-repository
+type UpdateSubmoduleOptions struct {
+	Branch        *string `url:"branch,omitempty" json:"branch,omitempty"`
+	CommitSHA     *string `url:"commit_sha,omitempty" json:"commit_sha,omitempty"`
+	CommitMessage *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+}
+
+// UpdateSubmodule updates the commit a submodule points to on the given
+// branch, and returns the commit that was created for the change. The
+// submodule is the path of the submodule in the repository.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repository_submodules.html#update-existing-submodule-reference-in-repository
+func (s *RepositorySubmodulesService) UpdateSubmodule(pid interface{}, submodule string, opt *UpdateSubmoduleOptions, options ...OptionFunc) (*Commit, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/submodules/%s", pathEscape(project), pathEscape(submodule))
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(Commit)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, err
+}
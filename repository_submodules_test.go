@@ -0,0 +1,32 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateSubmodule(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/submodules/lib%2Fmodule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"branch":"master","commit_sha":"3ddec28ea23acc5caa5d8331a6ecb2a65fc03e88","commit_message":"Update submodule"}`)
+		fmt.Fprint(w, `{"id": "ed899a2f4b50b4370feeea94676502b42383c746", "short_id": "ed899a2f4b5", "title": "Update submodule"}`)
+	})
+
+	opt := &UpdateSubmoduleOptions{
+		Branch:        String("master"),
+		CommitSHA:     String("3ddec28ea23acc5caa5d8331a6ecb2a65fc03e88"),
+		CommitMessage: String("Update submodule"),
+	}
+	commit, _, err := client.RepositorySubmodules.UpdateSubmodule(1, "lib/module", opt)
+	if err != nil {
+		t.Fatalf("RepositorySubmodules.UpdateSubmodule returned error: %v", err)
+	}
+
+	if commit.ShortID != "ed899a2f4b5" || commit.Title != "Update submodule" {
+		t.Errorf("RepositorySubmodules.UpdateSubmodule returned %+v", commit)
+	}
+}
@@ -0,0 +1,106 @@
+package gitlab
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// BackoffFunc returns how long to wait before the given retry attempt of a
+// request. The first retry is attempt 0.
+type BackoffFunc func(attempt int) time.Duration
+
+// ExponentialBackoff returns a BackoffFunc that doubles the wait time for
+// every attempt, starting at min and never exceeding max. The returned wait
+// times are jittered, to avoid that many clients retry at the same moment.
+func ExponentialBackoff(min, max time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		d := min
+		for i := 0; i < attempt && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		// Wait somewhere between half and the full backoff duration.
+		half := int64(d / 2)
+		if half <= 0 {
+			return d
+		}
+		return time.Duration(half + rand.Int63n(half+1))
+	}
+}
+
+// defaultBackoff is used by WithRetry when no BackoffFunc is given.
+var defaultBackoff = ExponentialBackoff(500*time.Millisecond, 30*time.Second)
+
+// WithRetry makes the client retry idempotent requests (GET, HEAD, OPTIONS,
+// PUT and DELETE) that fail with a 502, 503 or 504 response, or because the
+// connection was refused or reset, for example during GitLab maintenance.
+// The client waits for the duration returned by backoff before every retry,
+// and gives up after max retries. A nil backoff uses an exponential backoff
+// starting at 500ms, up to 30s.
+//
+// Rate limited requests are handled separately, see WithRateLimitRetry.
+func WithRetry(max int, backoff BackoffFunc) ClientOptionFunc {
+	return func(c *Client) {
+		if backoff == nil {
+			backoff = defaultBackoff
+		}
+		c.retryMax = max
+		c.retryBackoff = backoff
+	}
+}
+
+// send sends the request, retrying it on transient errors as configured
+// with WithRetry.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.client.Do(req)
+		if attempt >= c.retryMax || !isIdempotent(req.Method) || !isTransient(req, resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(req.Context(), c.retryBackoff(attempt)); err != nil {
+			return nil, err
+		}
+		if err := rewindBody(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isIdempotent reports whether requests using the given method can safely be
+// sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// isTransient reports whether the outcome of a request is likely to be
+// different when retried.
+func isTransient(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
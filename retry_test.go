@@ -0,0 +1,113 @@
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func noBackoff(int) time.Duration { return 0 }
+
+func TestRetryTransientErrors(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithRetry(2, noBackoff)(client)
+
+	var calls int
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	req, err := client.NewRequest("GET", "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithRetry(1, noBackoff)(client)
+
+	var calls int
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+
+	req, err := client.NewRequest("GET", "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	resp, err := client.Do(req, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if resp.StatusCode != http.StatusGatewayTimeout {
+		t.Errorf("Expected status %d, got %d", http.StatusGatewayTimeout, resp.StatusCode)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
+
+func TestRetrySkipsNonIdempotentRequests(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithRetry(2, noBackoff)(client)
+
+	var calls int
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, err := client.NewRequest("POST", "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(100*time.Millisecond, time.Second)
+
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{1, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond, 800 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 10; i++ {
+			if d := backoff(tt.attempt); d < tt.min || d > tt.max {
+				t.Errorf("backoff(%d) = %s, want between %s and %s", tt.attempt, d, tt.min, tt.max)
+			}
+		}
+	}
+}
@@ -19,7 +19,6 @@ package gitlab
 import (
 	"fmt"
 	"net"
-	"net/url"
 	"time"
 )
 
@@ -132,7 +131,7 @@ func (s *RunnersService) GetRunnerDetails(rid interface{}, options ...OptionFunc
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("runners/%s", runner)
+	u := fmt.Sprintf("runners/%s", pathEscape(runner))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -171,7 +170,7 @@ func (s *RunnersService) UpdateRunnerDetails(rid interface{}, opt *UpdateRunnerD
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("runners/%s", runner)
+	u := fmt.Sprintf("runners/%s", pathEscape(runner))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -196,7 +195,7 @@ func (s *RunnersService) RemoveRunner(rid interface{}, options ...OptionFunc) (*
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("runners/%s", runner)
+	u := fmt.Sprintf("runners/%s", pathEscape(runner))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -207,13 +206,17 @@ func (s *RunnersService) RemoveRunner(rid interface{}, options ...OptionFunc) (*
 }
 
 // ListRunnerJobsOptions represents the available ListRunnerJobs()
-// options. Status can be one of: running, success, failed, canceled.
+// options. Status can be one of: running, success, failed, canceled. Set
+// SystemID to only list the jobs of a single runner manager.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/runners.html#list-runner-39-s-jobs
 type ListRunnerJobsOptions struct {
 	ListOptions
-	Status *string `url:"status,omitempty" json:"status,omitempty"`
+	Status   *string `url:"status,omitempty" json:"status,omitempty"`
+	OrderBy  *string `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort     *string `url:"sort,omitempty" json:"sort,omitempty"`
+	SystemID *string `url:"system_id,omitempty" json:"system_id,omitempty"`
 }
 
 // ListRunnerJobs gets a list of jobs that are being processed or were processed by specified Runner.
@@ -225,7 +228,7 @@ func (s *RunnersService) ListRunnerJobs(rid interface{}, opt *ListRunnerJobsOpti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("runners/%s/jobs", runner)
+	u := fmt.Sprintf("runners/%s/jobs", pathEscape(runner))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -257,7 +260,7 @@ func (s *RunnersService) ListProjectRunners(pid interface{}, opt *ListProjectRun
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/runners", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/runners", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -291,7 +294,7 @@ func (s *RunnersService) EnableProjectRunner(pid interface{}, opt *EnableProject
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/runners", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/runners", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -320,7 +323,7 @@ func (s *RunnersService) DisableProjectRunner(pid interface{}, rid interface{},
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/runners/%s", url.QueryEscape(project), url.QueryEscape(runner))
+	u := fmt.Sprintf("projects/%s/runners/%s", pathEscape(project), pathEscape(runner))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -336,7 +339,7 @@ func (s *RunnersService) DisableProjectRunner(pid interface{}, rid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/runners.html#register-a-new-runner
 type RegisterNewRunnerOptions struct {
-	Token          *string  `url:"token" json:"token"`
+	Token          *string  `url:"token,omitempty" json:"token,omitempty"`
 	Description    *string  `url:"description,omitempty" json:"description,omitempty"`
 	Info           *string  `url:"info,omitempty" json:"info,omitempty"`
 	Active         *bool    `url:"active,omitempty" json:"active,omitempty"`
@@ -371,7 +374,7 @@ func (s *RunnersService) RegisterNewRunner(opt *RegisterNewRunnerOptions, option
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/runners.html#delete-a-registered-runner
 type DeleteRegisteredRunnerOptions struct {
-	Token *string `url:"token" json:"token"`
+	Token *string `url:"token,omitempty" json:"token,omitempty"`
 }
 
 // DeleteRegisteredRunner registers a new Runner for the instance.
@@ -393,7 +396,7 @@ func (s *RunnersService) DeleteRegisteredRunner(opt *DeleteRegisteredRunnerOptio
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/runners.html#verify-authentication-for-a-registered-runner
 type VerifyRegisteredRunnerOptions struct {
-	Token *string `url:"token" json:"token"`
+	Token *string `url:"token,omitempty" json:"token,omitempty"`
 }
 
 // VerifyRegisteredRunner registers a new Runner for the instance.
@@ -408,3 +411,138 @@ func (s *RunnersService) VerifyRegisteredRunner(opt *VerifyRegisteredRunnerOptio
 
 	return s.client.Do(req, nil)
 }
+
+// UserRunner represents a runner created with CreateUserRunner(). The token
+// is the authentication token the runner uses to communicate with GitLab.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#create-a-runner-linked-to-a-user
+type UserRunner struct {
+	ID             int        `json:"id"`
+	Token          string     `json:"token"`
+	TokenExpiresAt *time.Time `json:"token_expires_at"`
+}
+
+// CreateUserRunnerOptions represents the available CreateUserRunner()
+// options. RunnerType is one of "instance_type", "group_type" or
+// "project_type", and GroupID or ProjectID must be set for the latter two.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#create-a-runner-linked-to-a-user
+type CreateUserRunnerOptions struct {
+	RunnerType      *string  `url:"runner_type,omitempty" json:"runner_type,omitempty"`
+	GroupID         *int     `url:"group_id,omitempty" json:"group_id,omitempty"`
+	ProjectID       *int     `url:"project_id,omitempty" json:"project_id,omitempty"`
+	Description     *string  `url:"description,omitempty" json:"description,omitempty"`
+	Paused          *bool    `url:"paused,omitempty" json:"paused,omitempty"`
+	Locked          *bool    `url:"locked,omitempty" json:"locked,omitempty"`
+	RunUntagged     *bool    `url:"run_untagged,omitempty" json:"run_untagged,omitempty"`
+	TagList         []string `url:"tag_list[],omitempty" json:"tag_list,omitempty"`
+	AccessLevel     *string  `url:"access_level,omitempty" json:"access_level,omitempty"`
+	MaximumTimeout  *int     `url:"maximum_timeout,omitempty" json:"maximum_timeout,omitempty"`
+	MaintenanceNote *string  `url:"maintenance_note,omitempty" json:"maintenance_note,omitempty"`
+}
+
+// CreateUserRunner creates a runner linked to the current user, and returns
+// its authentication token. This replaces registering runners with a
+// registration token, which is deprecated.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#create-a-runner-linked-to-a-user
+func (s *RunnersService) CreateUserRunner(opt *CreateUserRunnerOptions, options ...OptionFunc) (*UserRunner, *Response, error) {
+	req, err := s.client.NewRequest("POST", "user/runners", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(UserRunner)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// RunnerManager represents a machine (runner manager) that is running a
+// runner and picking up its jobs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/runners.html#list-runners-managers
+type RunnerManager struct {
+	ID           int        `json:"id"`
+	SystemID     string     `json:"system_id"`
+	Version      string     `json:"version"`
+	Revision     string     `json:"revision"`
+	Platform     string     `json:"platform"`
+	Architecture string     `json:"architecture"`
+	CreatedAt    *time.Time `json:"created_at"`
+	ContactedAt  *time.Time `json:"contacted_at"`
+	IPAddress    string     `json:"ip_address"`
+	Status       string     `json:"status"`
+}
+
+func (r RunnerManager) String() string {
+	return Stringify(r)
+}
+
+// ListRunnerManagers gets the managers of a runner, one for every machine
+// the runner is registered on.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/runners.html#list-runners-managers
+func (s *RunnersService) ListRunnerManagers(rid interface{}, options ...OptionFunc) ([]*RunnerManager, *Response, error) {
+	runner, err := parseID(rid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("runners/%s/managers", pathEscape(runner))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rm []*RunnerManager
+	resp, err := s.client.Do(req, &rm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rm, resp, err
+}
+
+// RunnerAuthenticationToken represents a runner authentication token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/runners.html#reset-runners-authentication-token-by-using-the-runner-id
+type RunnerAuthenticationToken struct {
+	Token          string     `json:"token"`
+	TokenExpiresAt *time.Time `json:"token_expires_at"`
+}
+
+// ResetRunnerAuthenticationToken resets the authentication token of a runner.
+// The runner stops working until it is configured with the new token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/runners.html#reset-runners-authentication-token-by-using-the-runner-id
+func (s *RunnersService) ResetRunnerAuthenticationToken(rid interface{}, options ...OptionFunc) (*RunnerAuthenticationToken, *Response, error) {
+	runner, err := parseID(rid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("runners/%s/reset_authentication_token", pathEscape(runner))
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(RunnerAuthenticationToken)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
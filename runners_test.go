@@ -61,6 +61,32 @@ func TestListRunnersJobs(t *testing.T) {
 	}
 }
 
+func TestListRunnersJobsBySystemID(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/runners/1/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/runners/1/jobs?status=failed&system_id=s_89e5e9956577")
+		fmt.Fprint(w, `[{"id":3}]`)
+	})
+
+	opt := &ListRunnerJobsOptions{
+		Status:   String("failed"),
+		SystemID: String("s_89e5e9956577"),
+	}
+
+	jobs, _, err := client.Runners.ListRunnerJobs(1, opt)
+	if err != nil {
+		t.Fatalf("Runners.ListRunnersJobs returns an error: %v", err)
+	}
+
+	want := []*Job{{ID: 3}}
+	if !reflect.DeepEqual(want, jobs) {
+		t.Errorf("Runners.ListRunnersJobs returned %+v, want %+v", jobs, want)
+	}
+}
+
 func TestRemoveRunner(t *testing.T) {
 	mux, server, client := setup()
 	defer teardown(server)
@@ -265,3 +291,79 @@ func TestVerifyRegisteredRunner(t *testing.T) {
 		t.Errorf("Runners.VerifyRegisteredRunner returned returned status code  %+v, want %+v", resp.StatusCode, want)
 	}
 }
+
+func TestCreateUserRunner(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/user/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"runner_type":"group_type","group_id":5,"run_untagged":false,"tag_list":["docker","linux"]}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 9171, "token": "glrt-kyahzxLaj4Dc1jQf4xjX", "token_expires_at": null}`)
+	})
+
+	opt := &CreateUserRunnerOptions{
+		RunnerType:  String("group_type"),
+		GroupID:     Int(5),
+		RunUntagged: Bool(false),
+		TagList:     []string{"docker", "linux"},
+	}
+	runner, _, err := client.Runners.CreateUserRunner(opt)
+	if err != nil {
+		t.Fatalf("Runners.CreateUserRunner returns an error: %v", err)
+	}
+
+	want := &UserRunner{ID: 9171, Token: "glrt-kyahzxLaj4Dc1jQf4xjX"}
+	if !reflect.DeepEqual(want, runner) {
+		t.Errorf("Runners.CreateUserRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestListRunnerManagers(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/runners/1/managers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "system_id": "s_89e5e9956577", "version": "16.11.1", "platform": "linux", "architecture": "amd64", "ip_address": "127.0.0.1", "status": "online"}]`)
+	})
+
+	managers, _, err := client.Runners.ListRunnerManagers(1)
+	if err != nil {
+		t.Fatalf("Runners.ListRunnerManagers returns an error: %v", err)
+	}
+
+	want := []*RunnerManager{{
+		ID:           1,
+		SystemID:     "s_89e5e9956577",
+		Version:      "16.11.1",
+		Platform:     "linux",
+		Architecture: "amd64",
+		IPAddress:    "127.0.0.1",
+		Status:       "online",
+	}}
+	if !reflect.DeepEqual(want, managers) {
+		t.Errorf("Runners.ListRunnerManagers returned %+v, want %+v", managers, want)
+	}
+}
+
+func TestResetRunnerAuthenticationToken(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/runners/1/reset_authentication_token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"token": "6337ff461c94fd3fa32ba3b1ff4125", "token_expires_at": null}`)
+	})
+
+	token, _, err := client.Runners.ResetRunnerAuthenticationToken(1)
+	if err != nil {
+		t.Fatalf("Runners.ResetRunnerAuthenticationToken returns an error: %v", err)
+	}
+
+	want := &RunnerAuthenticationToken{Token: "6337ff461c94fd3fa32ba3b1ff4125"}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("Runners.ResetRunnerAuthenticationToken returned %+v, want %+v", token, want)
+	}
+}
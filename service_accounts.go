@@ -0,0 +1,201 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// ServiceAccountsService handles communication with the service account
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/user_service_accounts.html
+type ServiceAccountsService struct {
+	client *Client
+}
+
+// ServiceAccount represents a GitLab service account user.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/user_service_accounts.html
+type ServiceAccount struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+func (s ServiceAccount) String() string {
+	return Stringify(s)
+}
+
+// CreateServiceAccountOptions represents the available
+// CreateServiceAccount() and CreateGroupServiceAccount() options. GitLab
+// generates the name and username if they are not set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#create-a-service-account-user
+type CreateServiceAccountOptions struct {
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+	Username *string `url:"username,omitempty" json:"username,omitempty"`
+	Email    *string `url:"email,omitempty" json:"email,omitempty"`
+}
+
+// ListServiceAccountsOptions represents the available ListServiceAccounts()
+// and ListGroupServiceAccounts() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#list-all-service-account-users
+type ListServiceAccountsOptions struct {
+	ListOptions
+	OrderBy *string `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort    *string `url:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// CreateServiceAccountPersonalAccessTokenOptions represents the available
+// CreateServiceAccountPersonalAccessToken() and
+// CreateGroupServiceAccountPersonalAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#create-a-personal-access-token-for-a-service-account-user
+type CreateServiceAccountPersonalAccessTokenOptions struct {
+	Name      *string   `url:"name,omitempty" json:"name,omitempty"`
+	Scopes    *[]string `url:"scopes,omitempty" json:"scopes,omitempty"`
+	ExpiresAt *ISOTime  `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// CreateServiceAccount creates an instance service account. This is only
+// available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#create-a-service-account-user
+func (s *ServiceAccountsService) CreateServiceAccount(opt *CreateServiceAccountOptions, options ...OptionFunc) (*ServiceAccount, *Response, error) {
+	req, err := s.client.NewRequest("POST", "service_accounts", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sa := new(ServiceAccount)
+	resp, err := s.client.Do(req, sa)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sa, resp, err
+}
+
+// ListServiceAccounts gets a list of the instance service accounts. This is
+// only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/user_service_accounts.html#list-all-service-account-users
+func (s *ServiceAccountsService) ListServiceAccounts(opt *ListServiceAccountsOptions, options ...OptionFunc) ([]*ServiceAccount, *Response, error) {
+	req, err := s.client.NewRequest("GET", "service_accounts", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sas []*ServiceAccount
+	resp, err := s.client.Do(req, &sas)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sas, resp, err
+}
+
+// CreateServiceAccountPersonalAccessToken creates a personal access token for
+// an instance service account. This is only available to administrators.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-personal-access-token
+func (s *ServiceAccountsService) CreateServiceAccountPersonalAccessToken(user int, opt *CreateServiceAccountPersonalAccessTokenOptions, options ...OptionFunc) (*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("users/%d/personal_access_tokens", user)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// CreateGroupServiceAccount creates a service account in a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#create-a-service-account-user
+func (s *ServiceAccountsService) CreateGroupServiceAccount(gid interface{}, opt *CreateServiceAccountOptions, options ...OptionFunc) (*ServiceAccount, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sa := new(ServiceAccount)
+	resp, err := s.client.Do(req, sa)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sa, resp, err
+}
+
+// ListGroupServiceAccounts gets a list of the service accounts of a
+// top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#list-all-service-account-users
+func (s *ServiceAccountsService) ListGroupServiceAccounts(gid interface{}, opt *ListServiceAccountsOptions, options ...OptionFunc) ([]*ServiceAccount, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sas []*ServiceAccount
+	resp, err := s.client.Do(req, &sas)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sas, resp, err
+}
+
+// CreateGroupServiceAccountPersonalAccessToken creates a personal access
+// token for a service account of a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_service_accounts.html#create-a-personal-access-token-for-a-service-account-user
+func (s *ServiceAccountsService) CreateGroupServiceAccountPersonalAccessToken(gid interface{}, user int, opt *CreateServiceAccountPersonalAccessTokenOptions, options ...OptionFunc) (*PersonalAccessToken, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/service_accounts/%d/personal_access_tokens", pathEscape(group), user)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
@@ -0,0 +1,83 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateServiceAccount(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Deploy bot","username":"deploy-bot"}`)
+		fmt.Fprint(w, `{"id": 57, "username": "deploy-bot", "name": "Deploy bot"}`)
+	})
+
+	sa, _, err := client.ServiceAccounts.CreateServiceAccount(&CreateServiceAccountOptions{
+		Name:     String("Deploy bot"),
+		Username: String("deploy-bot"),
+	})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.CreateServiceAccount returned error: %v", err)
+	}
+
+	want := &ServiceAccount{ID: 57, Username: "deploy-bot", Name: "Deploy bot"}
+	if !reflect.DeepEqual(want, sa) {
+		t.Errorf("ServiceAccounts.CreateServiceAccount returned %+v, want %+v", sa, want)
+	}
+}
+
+func TestListGroupServiceAccounts(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/3/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/3/service_accounts?order_by=username&page=2")
+		fmt.Fprint(w, `[{"id": 57, "username": "service_account_group_345_6018816a18e515214e0c34c2b33523fc", "name": "Service account user"}]`)
+	})
+
+	sas, _, err := client.ServiceAccounts.ListGroupServiceAccounts(3, &ListServiceAccountsOptions{
+		ListOptions: ListOptions{Page: 2},
+		OrderBy:     String("username"),
+	})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.ListGroupServiceAccounts returned error: %v", err)
+	}
+
+	want := []*ServiceAccount{{ID: 57, Username: "service_account_group_345_6018816a18e515214e0c34c2b33523fc", Name: "Service account user"}}
+	if !reflect.DeepEqual(want, sas) {
+		t.Errorf("ServiceAccounts.ListGroupServiceAccounts returned %+v, want %+v", sas, want)
+	}
+}
+
+func TestCreateGroupServiceAccountPersonalAccessToken(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/3/service_accounts/57/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"ci","scopes":["api"],"expires_at":"2026-12-31"}`)
+		fmt.Fprint(w, `{"id": 6, "name": "ci", "active": true, "scopes": ["api"], "user_id": 57, "token": "glpat-secret"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC))
+	pat, _, err := client.ServiceAccounts.CreateGroupServiceAccountPersonalAccessToken(3, 57, &CreateServiceAccountPersonalAccessTokenOptions{
+		Name:      String("ci"),
+		Scopes:    &[]string{"api"},
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("ServiceAccounts.CreateGroupServiceAccountPersonalAccessToken returned error: %v", err)
+	}
+
+	want := &PersonalAccessToken{ID: 6, Name: "ci", Active: true, Scopes: []string{"api"}, UserID: 57, Token: "glpat-secret"}
+	if !reflect.DeepEqual(want, pat) {
+		t.Errorf("ServiceAccounts.CreateGroupServiceAccountPersonalAccessToken returned %+v, want %+v", pat, want)
+	}
+}
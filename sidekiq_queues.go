@@ -0,0 +1,53 @@
+package gitlab
+
+import "fmt"
+
+// DeleteSidekiqQueueOptions represents the available DeleteSidekiqQueue()
+// options. At least one of the metadata filters must be given; only the jobs
+// matching all given filters are deleted.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/admin_sidekiq_queues.html#delete-jobs-from-a-sidekiq-queue
+type DeleteSidekiqQueueOptions struct {
+	User             *string `url:"user,omitempty" json:"user,omitempty"`
+	Project          *string `url:"project,omitempty" json:"project,omitempty"`
+	RootNamespace    *string `url:"root_namespace,omitempty" json:"root_namespace,omitempty"`
+	SubscriptionPlan *string `url:"subscription_plan,omitempty" json:"subscription_plan,omitempty"`
+	CallerID         *string `url:"caller_id,omitempty" json:"caller_id,omitempty"`
+	FeatureCategory  *string `url:"feature_category,omitempty" json:"feature_category,omitempty"`
+	WorkerClass      *string `url:"worker_class,omitempty" json:"worker_class,omitempty"`
+}
+
+// DeletedSidekiqJobs represents the result of deleting jobs from a Sidekiq
+// queue. When Completed is false, the deletion timed out and can be repeated
+// to delete the remaining jobs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/admin_sidekiq_queues.html#delete-jobs-from-a-sidekiq-queue
+type DeletedSidekiqJobs struct {
+	Completed   bool `json:"completed"`
+	DeletedJobs int  `json:"deleted_jobs"`
+	QueueSize   int  `json:"queue_size"`
+}
+
+// DeleteSidekiqQueue deletes the jobs matching the metadata filters from a
+// Sidekiq queue. Available only for admins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/admin_sidekiq_queues.html#delete-jobs-from-a-sidekiq-queue
+func (s *SidekiqService) DeleteSidekiqQueue(queue string, opt *DeleteSidekiqQueueOptions, options ...OptionFunc) (*DeletedSidekiqJobs, *Response, error) {
+	u := fmt.Sprintf("admin/sidekiq/queues/%s", pathEscape(queue))
+
+	req, err := s.client.NewRequest("DELETE", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(DeletedSidekiqJobs)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, err
+}
@@ -0,0 +1,30 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDeleteSidekiqQueue(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/sidekiq/queues/authorized_projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/admin/sidekiq/queues/authorized_projects?project=group%2Fproject&user=root")
+		fmt.Fprint(w, `{"completed": true, "deleted_jobs": 7, "queue_size": 14}`)
+	})
+
+	opt := &DeleteSidekiqQueueOptions{User: String("root"), Project: String("group/project")}
+	deleted, _, err := client.Sidekiq.DeleteSidekiqQueue("authorized_projects", opt)
+	if err != nil {
+		t.Fatalf("Sidekiq.DeleteSidekiqQueue returned error: %v", err)
+	}
+
+	want := &DeletedSidekiqJobs{Completed: true, DeletedJobs: 7, QueueSize: 14}
+	if !reflect.DeepEqual(want, deleted) {
+		t.Errorf("Sidekiq.DeleteSidekiqQueue returned %+v, want %+v", deleted, want)
+	}
+}
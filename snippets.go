@@ -46,16 +46,25 @@ type Snippet struct {
 		State     string     `json:"state"`
 		CreatedAt *time.Time `json:"created_at"`
 	} `json:"author"`
-	UpdatedAt *time.Time `json:"updated_at"`
-	CreatedAt *time.Time `json:"created_at"`
-	WebURL    string     `json:"web_url"`
-	RawURL    string     `json:"raw_url"`
+	UpdatedAt *time.Time     `json:"updated_at"`
+	CreatedAt *time.Time     `json:"created_at"`
+	WebURL    string         `json:"web_url"`
+	RawURL    string         `json:"raw_url"`
+	Files     []*SnippetFile `json:"files"`
 }
 
 func (s Snippet) String() string {
 	return Stringify(s)
 }
 
+// SnippetFile represents a file of a GitLab snippet.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/snippets.html
+type SnippetFile struct {
+	Path   string `json:"path"`
+	RawURL string `json:"raw_url"`
+}
+
 // ListSnippetsOptions represents the available ListSnippets() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/snippets.html#list-snippets
@@ -204,6 +213,28 @@ func (s *SnippetsService) SnippetContent(snippet int, options ...OptionFunc) ([]
 	return b.Bytes(), resp, err
 }
 
+// SnippetFileContent returns the raw content of a single file of a snippet at
+// the given ref (usually "main" or a commit SHA).
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#snippet-repository-file-content
+func (s *SnippetsService) SnippetFileContent(snippet int, ref, filename string, options ...OptionFunc) ([]byte, *Response, error) {
+	u := fmt.Sprintf("snippets/%d/files/%s/%s/raw", snippet, pathEscape(ref), pathEscape(filename))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
 // ExploreSnippetsOptions represents the available ExploreSnippets() options.
 //
 // GitLab API docs:
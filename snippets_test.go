@@ -0,0 +1,26 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSnippetFileContent(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/1/files/main/lib%2Fhello.rb/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "puts 'hello'\n")
+	})
+
+	content, _, err := client.Snippets.SnippetFileContent(1, "main", "lib/hello.rb")
+	if err != nil {
+		t.Fatalf("Snippets.SnippetFileContent returned error: %v", err)
+	}
+
+	if want := "puts 'hello'\n"; string(content) != want {
+		t.Errorf("Snippets.SnippetFileContent returned %q, want %q", content, want)
+	}
+}
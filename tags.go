@@ -16,10 +16,7 @@
 
 package gitlab
 
-import (
-	"fmt"
-	"net/url"
-)
+import "fmt"
 
 // TagsService handles communication with the tags related methods
 // of the GitLab API.
@@ -39,14 +36,6 @@ type Tag struct {
 	Message string   `json:"message"`
 }
 
-// Release represents a GitLab version release.
-//
-// GitLab API docs: https://docs.gitlab.com/ce/api/tags.html
-type Release struct {
-	TagName     string `json:"tag_name"`
-	Description string `json:"description"`
-}
-
 func (t Tag) String() string {
 	return Stringify(t)
 }
@@ -71,7 +60,7 @@ func (s *TagsService) ListTags(pid interface{}, opt *ListTagsOptions, options ..
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/tags", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/tags", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -97,7 +86,7 @@ func (s *TagsService) GetTag(pid interface{}, tag string, options ...OptionFunc)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/tags/%s", url.QueryEscape(project), url.QueryEscape(tag))
+	u := fmt.Sprintf("projects/%s/repository/tags/%s", pathEscape(project), pathEscape(tag))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -121,7 +110,7 @@ type CreateTagOptions struct {
 	TagName            *string `url:"tag_name,omitempty" json:"tag_name,omitempty"`
 	Ref                *string `url:"ref,omitempty" json:"ref,omitempty"`
 	Message            *string `url:"message,omitempty" json:"message,omitempty"`
-	ReleaseDescription *string `url:"release_description:omitempty" json:"release_description,omitempty"`
+	ReleaseDescription *string `url:"release_description,omitempty" json:"release_description,omitempty"`
 }
 
 // CreateTag creates a new tag in the repository that points to the supplied ref.
@@ -133,7 +122,7 @@ func (s *TagsService) CreateTag(pid interface{}, opt *CreateTagOptions, options
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/tags", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/repository/tags", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -158,7 +147,7 @@ func (s *TagsService) DeleteTag(pid interface{}, tag string, options ...OptionFu
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/tags/%s", url.QueryEscape(project), url.QueryEscape(tag))
+	u := fmt.Sprintf("projects/%s/repository/tags/%s", pathEscape(project), pathEscape(tag))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -168,12 +157,12 @@ func (s *TagsService) DeleteTag(pid interface{}, tag string, options ...OptionFu
 	return s.client.Do(req, nil)
 }
 
-// CreateReleaseOptions represents the available CreateRelease() options.
+// CreateReleaseNoteOptions represents the available CreateRelease() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#create-a-new-release
-type CreateReleaseOptions struct {
-	Description *string `url:"description:omitempty" json:"description,omitempty"`
+type CreateReleaseNoteOptions struct {
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
 }
 
 // CreateRelease Add release notes to the existing git tag.
@@ -181,12 +170,12 @@ type CreateReleaseOptions struct {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#create-a-new-release
-func (s *TagsService) CreateRelease(pid interface{}, tag string, opt *CreateReleaseOptions, options ...OptionFunc) (*Release, *Response, error) {
+func (s *TagsService) CreateRelease(pid interface{}, tag string, opt *CreateReleaseNoteOptions, options ...OptionFunc) (*Release, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/tags/%s/release", url.QueryEscape(project), url.QueryEscape(tag))
+	u := fmt.Sprintf("projects/%s/repository/tags/%s/release", pathEscape(project), pathEscape(tag))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -202,24 +191,24 @@ func (s *TagsService) CreateRelease(pid interface{}, tag string, opt *CreateRele
 	return r, resp, err
 }
 
-// UpdateReleaseOptions represents the available UpdateRelease() options.
+// UpdateReleaseNoteOptions represents the available UpdateRelease() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#update-a-release
-type UpdateReleaseOptions struct {
-	Description *string `url:"description:omitempty" json:"description,omitempty"`
+type UpdateReleaseNoteOptions struct {
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
 }
 
 // UpdateRelease Updates the release notes of a given release.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/tags.html#update-a-release
-func (s *TagsService) UpdateRelease(pid interface{}, tag string, opt *UpdateReleaseOptions, options ...OptionFunc) (*Release, *Response, error) {
+func (s *TagsService) UpdateRelease(pid interface{}, tag string, opt *UpdateReleaseNoteOptions, options ...OptionFunc) (*Release, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/tags/%s/release", url.QueryEscape(project), url.QueryEscape(tag))
+	u := fmt.Sprintf("projects/%s/repository/tags/%s/release", pathEscape(project), pathEscape(tag))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -38,7 +38,7 @@ func TestCreateRelease(t *testing.T) {
 		fmt.Fprint(w, `{"tag_name": "1.0.0", "description": "Amazing release. Wow"}`)
 	})
 
-	opt := &CreateReleaseOptions{Description: String("Amazing release. Wow")}
+	opt := &CreateReleaseNoteOptions{Description: String("Amazing release. Wow")}
 
 	release, _, err := client.Tags.CreateRelease(1, "1.0.0", opt)
 	if err != nil {
@@ -60,7 +60,7 @@ func TestUpdateRelease(t *testing.T) {
 		fmt.Fprint(w, `{"tag_name": "1.0.0", "description": "Amazing release. Wow!"}`)
 	})
 
-	opt := &UpdateReleaseOptions{Description: String("Amazing release. Wow!")}
+	opt := &UpdateReleaseNoteOptions{Description: String("Amazing release. Wow!")}
 
 	release, _, err := client.Tags.UpdateRelease(1, "1.0.0", opt)
 	if err != nil {
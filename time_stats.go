@@ -2,7 +2,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // timeStatsService handles communication with the time tracking related
@@ -43,7 +42,7 @@ func (s *timeStatsService) setTimeEstimate(pid interface{}, entity string, issue
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/%s/%d/time_estimate", url.QueryEscape(project), entity, issue)
+	u := fmt.Sprintf("projects/%s/%s/%d/time_estimate", pathEscape(project), entity, issue)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -67,7 +66,7 @@ func (s *timeStatsService) resetTimeEstimate(pid interface{}, entity string, iss
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/%s/%d/reset_time_estimate", url.QueryEscape(project), entity, issue)
+	u := fmt.Sprintf("projects/%s/%s/%d/reset_time_estimate", pathEscape(project), entity, issue)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -98,7 +97,7 @@ func (s *timeStatsService) addSpentTime(pid interface{}, entity string, issue in
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/%s/%d/add_spent_time", url.QueryEscape(project), entity, issue)
+	u := fmt.Sprintf("projects/%s/%s/%d/add_spent_time", pathEscape(project), entity, issue)
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -122,7 +121,7 @@ func (s *timeStatsService) resetSpentTime(pid interface{}, entity string, issue
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/%s/%d/reset_spent_time", url.QueryEscape(project), entity, issue)
+	u := fmt.Sprintf("projects/%s/%s/%d/reset_spent_time", pathEscape(project), entity, issue)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -146,7 +145,7 @@ func (s *timeStatsService) getTimeSpent(pid interface{}, entity string, issue in
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/%s/%d/time_stats", url.QueryEscape(project), entity, issue)
+	u := fmt.Sprintf("projects/%s/%s/%d/time_stats", pathEscape(project), entity, issue)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -0,0 +1,100 @@
+package gitlab
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenRotationStrategy represents the strategy used to pick a token when
+// rotating between multiple tokens.
+type TokenRotationStrategy int
+
+// List of available token rotation strategies.
+const (
+	// RoundRobin uses the tokens one after the other.
+	RoundRobin TokenRotationStrategy = iota
+
+	// LeastRecentlyThrottled uses the token that was rate limited the longest
+	// time ago, preferring tokens that are not rate limited at all.
+	LeastRecentlyThrottled
+)
+
+// defaultThrottleDuration is used for rate limited tokens when the response
+// doesn't indicate when the rate limit is reset.
+const defaultThrottleDuration = time.Minute
+
+// WithTokenRotation makes the client rotate between the given tokens, so the
+// requests are spread over the rate limits of multiple tokens. The token that
+// was passed to the client constructor is not used. When a request is rate
+// limited, it is retried right away using another token that isn't rate
+// limited, if any.
+//
+// Token rotation is meant to be used with NewClient or NewOAuthClient, and
+// should not be combined with NewBasicAuthClient.
+func WithTokenRotation(tokens []string, strategy TokenRotationStrategy) ClientOptionFunc {
+	return func(c *Client) {
+		if len(tokens) == 0 {
+			return
+		}
+		c.tokens = &tokenPool{
+			strategy:       strategy,
+			tokens:         append([]string(nil), tokens...),
+			throttledUntil: make([]time.Time, len(tokens)),
+		}
+	}
+}
+
+// tokenPool keeps track of the tokens used for token rotation.
+type tokenPool struct {
+	strategy TokenRotationStrategy
+
+	mu             sync.Mutex
+	tokens         []string
+	throttledUntil []time.Time
+	next           int
+}
+
+// get returns the token to use for the next request.
+func (p *tokenPool) get() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	i := p.next
+	if p.strategy == LeastRecentlyThrottled {
+		// Start at the next token in line, so tokens that were never rate
+		// limited are still used round-robin.
+		for j := 1; j < len(p.tokens); j++ {
+			k := (p.next + j) % len(p.tokens)
+			if p.throttledUntil[k].Before(p.throttledUntil[i]) {
+				i = k
+			}
+		}
+	}
+
+	p.next = (i + 1) % len(p.tokens)
+	return p.tokens[i]
+}
+
+// throttle marks the token as rate limited for the given duration and
+// returns another token that is not rate limited, if any.
+func (p *tokenPool) throttle(token string, d time.Duration) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for i, t := range p.tokens {
+		if t == token {
+			p.throttledUntil[i] = now.Add(d)
+		}
+	}
+
+	for j := 0; j < len(p.tokens); j++ {
+		i := (p.next + j) % len(p.tokens)
+		if p.tokens[i] != token && !p.throttledUntil[i].After(now) {
+			p.next = (i + 1) % len(p.tokens)
+			return p.tokens[i], true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,83 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTokenRotationRoundRobin(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithTokenRotation([]string{"a", "b", "c"}, RoundRobin)(client)
+
+	var tokens []string
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Private-Token"))
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	for i := 0; i < 4; i++ {
+		if _, _, err := client.Users.CurrentUser(); err != nil {
+			t.Fatalf("Users.CurrentUser returned error: %v", err)
+		}
+	}
+
+	want := []string{"a", "b", "c", "a"}
+	if !reflect.DeepEqual(want, tokens) {
+		t.Errorf("Requests used tokens %q, want %q", tokens, want)
+	}
+}
+
+func TestTokenRotationLeastRecentlyThrottled(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithTokenRotation([]string{"a", "b"}, LeastRecentlyThrottled)(client)
+
+	var tokens []string
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Private-Token")
+		tokens = append(tokens, token)
+		if token == "a" {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := client.Users.CurrentUser(); err != nil {
+			t.Fatalf("Users.CurrentUser returned error: %v", err)
+		}
+	}
+
+	// The first request is retried with the second token, after which the
+	// throttled token is no longer used.
+	want := []string{"a", "b", "b", "b"}
+	if !reflect.DeepEqual(want, tokens) {
+		t.Errorf("Requests used tokens %q, want %q", tokens, want)
+	}
+}
+
+func TestTokenRotationAllThrottled(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	WithTokenRotation([]string{"a", "b"}, RoundRobin)(client)
+
+	var calls int
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, resp, err := client.Users.CurrentUser()
+	if err == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Users.CurrentUser returned %v, want a rate limit error", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
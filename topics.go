@@ -0,0 +1,172 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// TopicsService handles communication with the topics related methods
+// of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html
+type TopicsService struct {
+	client *Client
+}
+
+// Topic represents a GitLab project topic.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html
+type Topic struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	TotalProjectsCount int    `json:"total_projects_count"`
+	AvatarURL          string `json:"avatar_url"`
+}
+
+func (t Topic) String() string {
+	return Stringify(t)
+}
+
+// ListTopicsOptions represents the available ListTopics() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#list-topics
+type ListTopicsOptions struct {
+	ListOptions
+	Search          *string `url:"search,omitempty" json:"search,omitempty"`
+	WithoutProjects *bool   `url:"without_projects,omitempty" json:"without_projects,omitempty"`
+}
+
+// ListTopics returns a list of project topics in the GitLab instance ordered
+// by number of associated projects.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#list-topics
+func (s *TopicsService) ListTopics(opt *ListTopicsOptions, options ...OptionFunc) ([]*Topic, *Response, error) {
+	req, err := s.client.NewRequest("GET", "topics", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var t []*Topic
+	resp, err := s.client.Do(req, &t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// GetTopic gets a project topic by ID.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#get-a-topic
+func (s *TopicsService) GetTopic(topic int, options ...OptionFunc) (*Topic, *Response, error) {
+	u := fmt.Sprintf("topics/%d", topic)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// CreateTopicOptions represents the available CreateTopic() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#create-a-project-topic
+type CreateTopicOptions struct {
+	Name        *string `url:"name,omitempty" json:"name,omitempty"`
+	Title       *string `url:"title,omitempty" json:"title,omitempty"`
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
+}
+
+// CreateTopic creates a new project topic. Available only for
+// administrators.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#create-a-project-topic
+func (s *TopicsService) CreateTopic(opt *CreateTopicOptions, options ...OptionFunc) (*Topic, *Response, error) {
+	req, err := s.client.NewRequest("POST", "topics", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// UpdateTopicOptions represents the available UpdateTopic() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#update-a-project-topic
+type UpdateTopicOptions CreateTopicOptions
+
+// UpdateTopic updates a project topic. Available only for administrators.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#update-a-project-topic
+func (s *TopicsService) UpdateTopic(topic int, opt *UpdateTopicOptions, options ...OptionFunc) (*Topic, *Response, error) {
+	u := fmt.Sprintf("topics/%d", topic)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// DeleteTopic deletes a project topic. Available only for administrators.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#delete-a-project-topic
+func (s *TopicsService) DeleteTopic(topic int, options ...OptionFunc) (*Response, error) {
+	u := fmt.Sprintf("topics/%d", topic)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// MergeTopicsOptions represents the available MergeTopics() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#merge-topics
+type MergeTopicsOptions struct {
+	SourceTopicID *int `url:"source_topic_id,omitempty" json:"source_topic_id,omitempty"`
+	TargetTopicID *int `url:"target_topic_id,omitempty" json:"target_topic_id,omitempty"`
+}
+
+// MergeTopics merges the source topic into the target topic. All projects of
+// the source topic are assigned to the target topic and the source topic is
+// deleted. Available only for administrators.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/topics.html#merge-topics
+func (s *TopicsService) MergeTopics(opt *MergeTopicsOptions, options ...OptionFunc) (*Topic, *Response, error) {
+	req, err := s.client.NewRequest("POST", "topics/merge", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Topic)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
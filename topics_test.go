@@ -0,0 +1,51 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListTopics(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/topics?search=go")
+		fmt.Fprint(w, `[{"id": 1, "name": "golang", "total_projects_count": 3}]`)
+	})
+
+	topics, _, err := client.Topics.ListTopics(&ListTopicsOptions{Search: String("go")})
+	if err != nil {
+		t.Fatalf("Topics.ListTopics returned error: %v", err)
+	}
+
+	want := []*Topic{{ID: 1, Name: "golang", TotalProjectsCount: 3}}
+	if !reflect.DeepEqual(want, topics) {
+		t.Errorf("Topics.ListTopics returned %+v, want %+v", topics, want)
+	}
+}
+
+func TestMergeTopics(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/topics/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"source_topic_id":2,"target_topic_id":1}`)
+		fmt.Fprint(w, `{"id": 1, "name": "golang", "total_projects_count": 5}`)
+	})
+
+	opt := &MergeTopicsOptions{SourceTopicID: Int(2), TargetTopicID: Int(1)}
+	topic, _, err := client.Topics.MergeTopics(opt)
+	if err != nil {
+		t.Fatalf("Topics.MergeTopics returned error: %v", err)
+	}
+
+	want := &Topic{ID: 1, Name: "golang", TotalProjectsCount: 5}
+	if !reflect.DeepEqual(want, topic) {
+		t.Errorf("Topics.MergeTopics returned %+v, want %+v", topic, want)
+	}
+}
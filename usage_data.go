@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"bytes"
+)
+
+// UsageDataService handles communication with the service ping related
+// methods of the GitLab API. These methods are only available for
+// administrators.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/usage_data.html
+type UsageDataService struct {
+	client *Client
+}
+
+// ServicePing represents the service ping payload of a GitLab instance. The
+// payload is versioned together with GitLab itself, so it is exposed as a
+// generic JSON object.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-data
+type ServicePing map[string]interface{}
+
+// GetServicePing gets the service ping payload as it would currently be
+// reported by the instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-data
+func (s *UsageDataService) GetServicePing(options ...OptionFunc) (ServicePing, *Response, error) {
+	return s.getUsageData("usage_data/service_ping", options)
+}
+
+// GetNonSQLMetrics gets the non SQL metrics of the service ping payload.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-non-sql-metrics
+func (s *UsageDataService) GetNonSQLMetrics(options ...OptionFunc) (ServicePing, *Response, error) {
+	return s.getUsageData("usage_data/non_sql_metrics", options)
+}
+
+// GetQueries gets the SQL queries used to calculate the service ping
+// payload, instead of their results.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-service-ping-sql-queries
+func (s *UsageDataService) GetQueries(options ...OptionFunc) (ServicePing, *Response, error) {
+	return s.getUsageData("usage_data/queries", options)
+}
+
+// GetMetricDefinitionsOptions represents the available
+// GetMetricDefinitions() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-metric-definitions-as-a-single-yaml-file
+type GetMetricDefinitionsOptions struct {
+	IncludePaths *bool `url:"include_paths,omitempty" json:"include_paths,omitempty"`
+}
+
+// GetMetricDefinitions gets the definitions of all service ping metrics as
+// a single YAML document.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/usage_data.html#export-metric-definitions-as-a-single-yaml-file
+func (s *UsageDataService) GetMetricDefinitions(opt *GetMetricDefinitionsOptions, options ...OptionFunc) ([]byte, *Response, error) {
+	req, err := s.client.NewRequest("GET", "usage_data/metric_definitions", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
+func (s *UsageDataService) getUsageData(u string, options []OptionFunc) (ServicePing, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sp ServicePing
+	resp, err := s.client.Do(req, &sp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sp, resp, err
+}
@@ -0,0 +1,51 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetServicePing(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/usage_data/service_ping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uuid": "0000-0000", "version": "14.0.0", "counts": {"issues": 42}}`)
+	})
+
+	ping, _, err := client.UsageData.GetServicePing()
+	if err != nil {
+		t.Fatalf("UsageData.GetServicePing returned error: %v", err)
+	}
+
+	if ping["uuid"] != "0000-0000" || ping["version"] != "14.0.0" {
+		t.Errorf("UsageData.GetServicePing returned %+v", ping)
+	}
+	counts, ok := ping["counts"].(map[string]interface{})
+	if !ok || counts["issues"] != float64(42) {
+		t.Errorf("UsageData.GetServicePing returned counts %+v", ping["counts"])
+	}
+}
+
+func TestGetMetricDefinitions(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/usage_data/metric_definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/usage_data/metric_definitions?include_paths=true")
+		fmt.Fprint(w, "---\n- key_path: counts.issues\n")
+	})
+
+	definitions, _, err := client.UsageData.GetMetricDefinitions(&GetMetricDefinitionsOptions{IncludePaths: Bool(true)})
+	if err != nil {
+		t.Fatalf("UsageData.GetMetricDefinitions returned error: %v", err)
+	}
+
+	want := "---\n- key_path: counts.issues\n"
+	if string(definitions) != want {
+		t.Errorf("UsageData.GetMetricDefinitions returned %q, want %q", definitions, want)
+	}
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"errors"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -69,6 +70,18 @@ type User struct {
 	CustomAttributes          []*CustomAttribute `json:"custom_attributes"`
 }
 
+// BasicUser represents the basic attributes of a GitLab user, as included
+// in other resources like the assignees and reviewers of merge requests.
+type BasicUser struct {
+	ID        int        `json:"id"`
+	Username  string     `json:"username"`
+	Name      string     `json:"name"`
+	State     string     `json:"state"`
+	CreatedAt *time.Time `json:"created_at"`
+	AvatarURL string     `json:"avatar_url"`
+	WebURL    string     `json:"web_url"`
+}
+
 // UserIdentity represents a user identity.
 type UserIdentity struct {
 	Provider  string `json:"provider"`
@@ -221,6 +234,28 @@ func (s *UsersService) ModifyUser(user int, opt *ModifyUserOptions, options ...O
 	return usr, resp, err
 }
 
+// UploadUserAvatar uploads an avatar for a user. The content is read from
+// avatar and uploaded using the given filename. Only administrators can
+// change the avatar of other users.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#user-modification
+func (s *UsersService) UploadUserAvatar(user int, avatar io.Reader, filename string, options ...OptionFunc) (*User, *Response, error) {
+	u := fmt.Sprintf("users/%d", user)
+
+	req, err := s.client.UploadRequest("PUT", u, avatar, filename, "avatar", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usr := new(User)
+	resp, err := s.client.Do(req, usr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usr, resp, err
+}
+
 // DeleteUser deletes a user. Available only for administrators. This is an
 // idempotent function, calling this function for a non-existent user id still
 // returns a status code 200 OK. The JSON response differs if the user was
@@ -257,14 +292,43 @@ func (s *UsersService) CurrentUser(options ...OptionFunc) (*User, *Response, err
 	return usr, resp, err
 }
 
+// UserAvatar represents the avatar of a GitLab user.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#upload-a-current-user-avatar
+type UserAvatar struct {
+	AvatarURL string `json:"avatar_url"`
+}
+
+// UploadAvatar uploads an avatar for the current user. The content is read
+// from avatar and uploaded using the given filename.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#upload-a-current-user-avatar
+func (s *UsersService) UploadAvatar(avatar io.Reader, filename string, options ...OptionFunc) (*UserAvatar, *Response, error) {
+	req, err := s.client.UploadRequest("PUT", "user/avatar", avatar, filename, "avatar", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(UserAvatar)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
 // SSHKey represents a SSH key.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#list-ssh-keys
 type SSHKey struct {
-	ID        int        `json:"id"`
-	Title     string     `json:"title"`
-	Key       string     `json:"key"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID         int        `json:"id"`
+	Title      string     `json:"title"`
+	Key        string     `json:"key"`
+	UsageType  string     `json:"usage_type"`
+	CreatedAt  *time.Time `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
 }
 
 // ListSSHKeys gets a list of currently authenticated user's SSH keys.
@@ -333,12 +397,15 @@ func (s *UsersService) GetSSHKey(key int, options ...OptionFunc) (*SSHKey, *Resp
 	return k, resp, err
 }
 
-// AddSSHKeyOptions represents the available AddSSHKey() options.
+// AddSSHKeyOptions represents the available AddSSHKey() options. UsageType
+// is one of "auth", "signing" or "auth_and_signing", the default.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#add-ssh-key
 type AddSSHKeyOptions struct {
-	Title *string `url:"title,omitempty" json:"title,omitempty"`
-	Key   *string `url:"key,omitempty" json:"key,omitempty"`
+	Title     *string    `url:"title,omitempty" json:"title,omitempty"`
+	Key       *string    `url:"key,omitempty" json:"key,omitempty"`
+	ExpiresAt *time.Time `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+	UsageType *string    `url:"usage_type,omitempty" json:"usage_type,omitempty"`
 }
 
 // AddSSHKey creates a new key owned by the currently authenticated user.
@@ -750,10 +817,13 @@ type UserActivity struct {
 // GitLap API docs:
 // https://docs.gitlab.com/ce/api/users.html#get-user-activities-admin-only
 type GetUserActivitiesOptions struct {
+	ListOptions
 	From *ISOTime `url:"from,omitempty" json:"from,omitempty"`
 }
 
-// GetUserActivities retrieves user activities (admin only)
+// GetUserActivities retrieves user activities (admin only). Only users that
+// were active on or after the From date (default 6 months ago) are listed,
+// so users without activity since then can be considered dormant.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#get-user-activities-admin-only
@@ -0,0 +1,131 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetUserActivities(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/user/activities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/user/activities?from=2026-01-01&page=2&per_page=100")
+		fmt.Fprint(w, `[{"username": "user1", "last_activity_on": "2026-03-15"}, {"username": "user2", "last_activity_on": "2026-01-02"}]`)
+	})
+
+	from := ISOTime(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	opt := &GetUserActivitiesOptions{
+		ListOptions: ListOptions{Page: 2, PerPage: 100},
+		From:        &from,
+	}
+	activities, _, err := client.Users.GetUserActivities(opt)
+	if err != nil {
+		t.Fatalf("Users.GetUserActivities returned error: %v", err)
+	}
+
+	on1 := ISOTime(time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC))
+	on2 := ISOTime(time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC))
+	want := []*UserActivity{
+		{Username: "user1", LastActivityOn: &on1},
+		{Username: "user2", LastActivityOn: &on2},
+	}
+	if !reflect.DeepEqual(want, activities) {
+		t.Errorf("Users.GetUserActivities returned %+v, want %+v", activities, want)
+	}
+}
+
+func TestUploadAvatar(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/user/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if _, h, err := r.FormFile("avatar"); err != nil || h.Filename != "avatar.png" {
+			t.Errorf("Users.UploadAvatar request has no avatar.png avatar file: %v", err)
+		}
+		fmt.Fprint(w, `{"avatar_url": "http://gdk.test:3000/uploads/-/system/user/avatar/76/avatar.png"}`)
+	})
+
+	avatar, _, err := client.Users.UploadAvatar(strings.NewReader("png"), "avatar.png")
+	if err != nil {
+		t.Fatalf("Users.UploadAvatar returned error: %v", err)
+	}
+
+	want := &UserAvatar{AvatarURL: "http://gdk.test:3000/uploads/-/system/user/avatar/76/avatar.png"}
+	if !reflect.DeepEqual(want, avatar) {
+		t.Errorf("Users.UploadAvatar returned %+v, want %+v", avatar, want)
+	}
+}
+
+func TestUploadUserAvatar(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/76", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if _, _, err := r.FormFile("avatar"); err != nil {
+			t.Errorf("Users.UploadUserAvatar request has no avatar file: %v", err)
+		}
+		fmt.Fprint(w, `{"id": 76, "avatar_url": "http://gdk.test:3000/uploads/-/system/user/avatar/76/avatar.png"}`)
+	})
+
+	user, _, err := client.Users.UploadUserAvatar(76, strings.NewReader("png"), "avatar.png")
+	if err != nil {
+		t.Fatalf("Users.UploadUserAvatar returned error: %v", err)
+	}
+
+	want := &User{ID: 76, AvatarURL: "http://gdk.test:3000/uploads/-/system/user/avatar/76/avatar.png"}
+	if !reflect.DeepEqual(want, user) {
+		t.Errorf("Users.UploadUserAvatar returned %+v, want %+v", user, want)
+	}
+}
+
+func TestAddSSHKeyWithExpiry(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/user/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"laptop","key":"ssh-ed25519 AAAA","expires_at":"2027-01-01T00:00:00Z","usage_type":"auth"}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"title": "laptop",
+			"key": "ssh-ed25519 AAAA",
+			"usage_type": "auth",
+			"created_at": "2026-10-16T09:00:00Z",
+			"expires_at": "2027-01-01T00:00:00Z",
+			"last_used_at": null
+		}`)
+	})
+
+	expiresAt := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opt := &AddSSHKeyOptions{
+		Title:     String("laptop"),
+		Key:       String("ssh-ed25519 AAAA"),
+		ExpiresAt: &expiresAt,
+		UsageType: String("auth"),
+	}
+	key, _, err := client.Users.AddSSHKey(opt)
+	if err != nil {
+		t.Fatalf("Users.AddSSHKey returned error: %v", err)
+	}
+
+	createdAt := time.Date(2026, time.October, 16, 9, 0, 0, 0, time.UTC)
+	want := &SSHKey{
+		ID:        1,
+		Title:     "laptop",
+		Key:       "ssh-ed25519 AAAA",
+		UsageType: "auth",
+		CreatedAt: &createdAt,
+		ExpiresAt: &expiresAt,
+	}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Users.AddSSHKey returned %+v, want %+v", key, want)
+	}
+}
@@ -1,6 +1,8 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -27,3 +29,20 @@ func TestGetVersion(t *testing.T) {
 		t.Errorf("Version.GetVersion returned %+v, want %+v", version, want)
 	}
 }
+
+func TestGetVersionWithContext(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request was sent with a canceled context")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := client.Version.GetVersion(WithContext(ctx))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Version.GetVersion returned error %v, want %v", err, context.Canceled)
+	}
+}
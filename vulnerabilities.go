@@ -0,0 +1,150 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// VulnerabilitiesService handles communication with the vulnerability
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/vulnerabilities.html
+type VulnerabilitiesService struct {
+	client *Client
+}
+
+// Vulnerability represents a GitLab project vulnerability.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/vulnerabilities.html
+type Vulnerability struct {
+	ID                      int        `json:"id"`
+	Title                   string     `json:"title"`
+	Description             string     `json:"description"`
+	State                   string     `json:"state"`
+	Severity                string     `json:"severity"`
+	Confidence              string     `json:"confidence"`
+	ReportType              string     `json:"report_type"`
+	Project                 *Project   `json:"project"`
+	AuthorID                int        `json:"author_id"`
+	UpdatedByID             int        `json:"updated_by_id"`
+	LastEditedByID          int        `json:"last_edited_by_id"`
+	ClosedByID              int        `json:"closed_by_id"`
+	ConfirmedByID           int        `json:"confirmed_by_id"`
+	DismissedByID           int        `json:"dismissed_by_id"`
+	ResolvedByID            int        `json:"resolved_by_id"`
+	ResolvedOnDefaultBranch bool       `json:"resolved_on_default_branch"`
+	CreatedAt               *time.Time `json:"created_at"`
+	UpdatedAt               *time.Time `json:"updated_at"`
+	ClosedAt                *time.Time `json:"closed_at"`
+	ConfirmedAt             *time.Time `json:"confirmed_at"`
+	DismissedAt             *time.Time `json:"dismissed_at"`
+	ResolvedAt              *time.Time `json:"resolved_at"`
+}
+
+func (v Vulnerability) String() string {
+	return Stringify(v)
+}
+
+// ListProjectVulnerabilitiesOptions represents the available
+// ListProjectVulnerabilities() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#list-project-vulnerabilities
+type ListProjectVulnerabilitiesOptions struct {
+	ListOptions
+}
+
+// ListProjectVulnerabilities gets a list of all project vulnerabilities.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#list-project-vulnerabilities
+func (s *VulnerabilitiesService) ListProjectVulnerabilities(pid interface{}, opt *ListProjectVulnerabilitiesOptions, options ...OptionFunc) ([]*Vulnerability, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/vulnerabilities", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var v []*Vulnerability
+	resp, err := s.client.Do(req, &v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, err
+}
+
+// GetVulnerability gets a single vulnerability.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#single-vulnerability
+func (s *VulnerabilitiesService) GetVulnerability(vulnerability int, options ...OptionFunc) (*Vulnerability, *Response, error) {
+	u := fmt.Sprintf("vulnerabilities/%d", vulnerability)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(Vulnerability)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, err
+}
+
+// ConfirmVulnerability confirms a given vulnerability.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#confirm-vulnerability
+func (s *VulnerabilitiesService) ConfirmVulnerability(vulnerability int, options ...OptionFunc) (*Vulnerability, *Response, error) {
+	return s.changeVulnerabilityState(vulnerability, "confirm", options)
+}
+
+// ResolveVulnerability resolves a given vulnerability.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#resolve-vulnerability
+func (s *VulnerabilitiesService) ResolveVulnerability(vulnerability int, options ...OptionFunc) (*Vulnerability, *Response, error) {
+	return s.changeVulnerabilityState(vulnerability, "resolve", options)
+}
+
+// DismissVulnerability dismisses a given vulnerability.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#dismiss-vulnerability
+func (s *VulnerabilitiesService) DismissVulnerability(vulnerability int, options ...OptionFunc) (*Vulnerability, *Response, error) {
+	return s.changeVulnerabilityState(vulnerability, "dismiss", options)
+}
+
+// RevertVulnerability reverts a given vulnerability to the detected state.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html#revert-vulnerability-to-detected-state
+func (s *VulnerabilitiesService) RevertVulnerability(vulnerability int, options ...OptionFunc) (*Vulnerability, *Response, error) {
+	return s.changeVulnerabilityState(vulnerability, "revert", options)
+}
+
+func (s *VulnerabilitiesService) changeVulnerabilityState(vulnerability int, action string, options []OptionFunc) (*Vulnerability, *Response, error) {
+	u := fmt.Sprintf("vulnerabilities/%d/%s", vulnerability, action)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(Vulnerability)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, err
+}
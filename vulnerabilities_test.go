@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectVulnerabilities(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/vulnerabilities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "title": "SQL injection", "state": "detected", "severity": "high"}]`)
+	})
+
+	vulnerabilities, _, err := client.Vulnerabilities.ListProjectVulnerabilities(1, nil)
+	if err != nil {
+		t.Fatalf("Vulnerabilities.ListProjectVulnerabilities returned error: %v", err)
+	}
+
+	want := []*Vulnerability{{ID: 1, Title: "SQL injection", State: "detected", Severity: "high"}}
+	if !reflect.DeepEqual(want, vulnerabilities) {
+		t.Errorf("Vulnerabilities.ListProjectVulnerabilities returned %+v, want %+v", vulnerabilities, want)
+	}
+}
+
+func TestDismissVulnerability(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/vulnerabilities/1/dismiss", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 1, "state": "dismissed"}`)
+	})
+
+	vulnerability, _, err := client.Vulnerabilities.DismissVulnerability(1)
+	if err != nil {
+		t.Fatalf("Vulnerabilities.DismissVulnerability returned error: %v", err)
+	}
+
+	want := &Vulnerability{ID: 1, State: "dismissed"}
+	if !reflect.DeepEqual(want, vulnerability) {
+		t.Errorf("Vulnerabilities.DismissVulnerability returned %+v, want %+v", vulnerability, want)
+	}
+}
+
+func TestListProjectVulnerabilityFindings(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/vulnerability_findings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/vulnerability_findings?pipeline_id=5&severity%5B%5D=high&severity%5B%5D=critical")
+		fmt.Fprint(w, `[{"id": 1, "name": "SQL injection", "severity": "high", "pipeline": {"id": 5}}]`)
+	})
+
+	findings, _, err := client.VulnerabilityFindings.ListProjectVulnerabilityFindings(1, &ListProjectVulnerabilityFindingsOptions{
+		Severity:   []string{"high", "critical"},
+		PipelineID: Int(5),
+	})
+	if err != nil {
+		t.Fatalf("VulnerabilityFindings.ListProjectVulnerabilityFindings returned error: %v", err)
+	}
+
+	if len(findings) != 1 || findings[0].Name != "SQL injection" || findings[0].Pipeline.ID != 5 {
+		t.Errorf("VulnerabilityFindings.ListProjectVulnerabilityFindings returned %+v", findings)
+	}
+}
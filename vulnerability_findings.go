@@ -0,0 +1,114 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// VulnerabilityFindingsService handles communication with the vulnerability
+// findings related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/vulnerability_findings.html
+type VulnerabilityFindingsService struct {
+	client *Client
+}
+
+// VulnerabilityFinding represents a GitLab vulnerability finding.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/vulnerability_findings.html
+type VulnerabilityFinding struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	Solution           string `json:"solution"`
+	Severity           string `json:"severity"`
+	Confidence         string `json:"confidence"`
+	ReportType         string `json:"report_type"`
+	State              string `json:"state"`
+	UUID               string `json:"uuid"`
+	ProjectFingerprint string `json:"project_fingerprint"`
+	Location           struct {
+		File       string `json:"file"`
+		StartLine  int    `json:"start_line"`
+		EndLine    int    `json:"end_line"`
+		Class      string `json:"class"`
+		Method     string `json:"method"`
+		Image      string `json:"image"`
+		Dependency struct {
+			Package struct {
+				Name string `json:"name"`
+			} `json:"package"`
+			Version string `json:"version"`
+		} `json:"dependency"`
+	} `json:"location"`
+	Identifiers []struct {
+		ExternalType string `json:"external_type"`
+		ExternalID   string `json:"external_id"`
+		Name         string `json:"name"`
+		URL          string `json:"url"`
+	} `json:"identifiers"`
+	Scanner struct {
+		ExternalID string `json:"external_id"`
+		Name       string `json:"name"`
+		Vendor     string `json:"vendor"`
+	} `json:"scanner"`
+	Links []struct {
+		URL string `json:"url"`
+	} `json:"links"`
+	Project struct {
+		ID       int    `json:"id"`
+		Name     string `json:"name"`
+		FullPath string `json:"full_path"`
+		FullName string `json:"full_name"`
+	} `json:"project"`
+	Pipeline struct {
+		ID     int    `json:"id"`
+		SHA    string `json:"sha"`
+		Ref    string `json:"ref"`
+		Status string `json:"status"`
+	} `json:"pipeline"`
+}
+
+func (v VulnerabilityFinding) String() string {
+	return Stringify(v)
+}
+
+// ListProjectVulnerabilityFindingsOptions represents the available
+// ListProjectVulnerabilityFindings() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_findings.html#list-project-vulnerability-findings
+type ListProjectVulnerabilityFindingsOptions struct {
+	ListOptions
+	ReportType []string `url:"report_type[],omitempty" json:"report_type,omitempty"`
+	Scope      *string  `url:"scope,omitempty" json:"scope,omitempty"`
+	Severity   []string `url:"severity[],omitempty" json:"severity,omitempty"`
+	Confidence []string `url:"confidence[],omitempty" json:"confidence,omitempty"`
+	PipelineID *int     `url:"pipeline_id,omitempty" json:"pipeline_id,omitempty"`
+}
+
+// ListProjectVulnerabilityFindings gets a list of all vulnerability findings
+// of a project. Set the PipelineID option to get the findings of a specific
+// pipeline instead of the latest pipeline on the default branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_findings.html#list-project-vulnerability-findings
+func (s *VulnerabilityFindingsService) ListProjectVulnerabilityFindings(pid interface{}, opt *ListProjectVulnerabilityFindingsOptions, options ...OptionFunc) ([]*VulnerabilityFinding, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/vulnerability_findings", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var f []*VulnerabilityFinding
+	resp, err := s.client.Do(req, &f)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return f, resp, err
+}
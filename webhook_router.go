@@ -0,0 +1,187 @@
+package gitlab
+
+import (
+	"crypto/subtle"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+)
+
+const eventTokenHeader = "X-Gitlab-Token"
+
+// WebhookRouter is an http.Handler that parses incoming webhook requests and
+// dispatches the parsed events to the handlers registered for them.
+//
+// Example usage:
+//
+//	router := gitlab.NewWebhookRouter("my-secret-token")
+//	router.OnMergeRequest(func(event *gitlab.MergeEvent) {
+//		...
+//	})
+//	router.OnPipeline(func(event *gitlab.PipelineEvent) {
+//		...
+//	})
+//	http.Handle("/webhook", router)
+type WebhookRouter struct {
+	secret     string
+	handlers   map[reflect.Type][]func(interface{})
+	unknown    func(EventType, []byte)
+	middleware []func(http.Handler) http.Handler
+}
+
+// NewWebhookRouter returns a new WebhookRouter. If secret is not empty, only
+// requests with a matching X-Gitlab-Token header will be accepted.
+func NewWebhookRouter(secret string) *WebhookRouter {
+	return &WebhookRouter{
+		secret:   secret,
+		handlers: make(map[reflect.Type][]func(interface{})),
+	}
+}
+
+// Use adds middleware that wraps the handling of every webhook request.
+// Middleware is called in the order it is added.
+func (wr *WebhookRouter) Use(middleware ...func(http.Handler) http.Handler) {
+	wr.middleware = append(wr.middleware, middleware...)
+}
+
+// OnUnknown registers a handler that is called for event types the router
+// does not recognize. Without such a handler unknown events are ignored.
+func (wr *WebhookRouter) OnUnknown(fn func(eventType EventType, payload []byte)) {
+	wr.unknown = fn
+}
+
+// OnBuild registers a handler for build events.
+func (wr *WebhookRouter) OnBuild(fn func(*BuildEvent)) {
+	wr.on(&BuildEvent{}, func(e interface{}) { fn(e.(*BuildEvent)) })
+}
+
+// OnCommitComment registers a handler for comments on commits.
+func (wr *WebhookRouter) OnCommitComment(fn func(*CommitCommentEvent)) {
+	wr.on(&CommitCommentEvent{}, func(e interface{}) { fn(e.(*CommitCommentEvent)) })
+}
+
+// OnDeployment registers a handler for deployment events.
+func (wr *WebhookRouter) OnDeployment(fn func(*DeploymentEvent)) {
+	wr.on(&DeploymentEvent{}, func(e interface{}) { fn(e.(*DeploymentEvent)) })
+}
+
+// OnEmoji registers a handler for emoji events.
+func (wr *WebhookRouter) OnEmoji(fn func(*EmojiEvent)) {
+	wr.on(&EmojiEvent{}, func(e interface{}) { fn(e.(*EmojiEvent)) })
+}
+
+// OnFeatureFlag registers a handler for feature flag events.
+func (wr *WebhookRouter) OnFeatureFlag(fn func(*FeatureFlagEvent)) {
+	wr.on(&FeatureFlagEvent{}, func(e interface{}) { fn(e.(*FeatureFlagEvent)) })
+}
+
+// OnIssue registers a handler for (confidential) issue events.
+func (wr *WebhookRouter) OnIssue(fn func(*IssueEvent)) {
+	wr.on(&IssueEvent{}, func(e interface{}) { fn(e.(*IssueEvent)) })
+}
+
+// OnIssueComment registers a handler for comments on issues.
+func (wr *WebhookRouter) OnIssueComment(fn func(*IssueCommentEvent)) {
+	wr.on(&IssueCommentEvent{}, func(e interface{}) { fn(e.(*IssueCommentEvent)) })
+}
+
+// OnJob registers a handler for job events.
+func (wr *WebhookRouter) OnJob(fn func(*JobEvent)) {
+	wr.on(&JobEvent{}, func(e interface{}) { fn(e.(*JobEvent)) })
+}
+
+// OnMergeRequest registers a handler for merge request events.
+func (wr *WebhookRouter) OnMergeRequest(fn func(*MergeEvent)) {
+	wr.on(&MergeEvent{}, func(e interface{}) { fn(e.(*MergeEvent)) })
+}
+
+// OnMergeRequestComment registers a handler for comments on merge requests.
+func (wr *WebhookRouter) OnMergeRequestComment(fn func(*MergeCommentEvent)) {
+	wr.on(&MergeCommentEvent{}, func(e interface{}) { fn(e.(*MergeCommentEvent)) })
+}
+
+// OnPipeline registers a handler for pipeline events.
+func (wr *WebhookRouter) OnPipeline(fn func(*PipelineEvent)) {
+	wr.on(&PipelineEvent{}, func(e interface{}) { fn(e.(*PipelineEvent)) })
+}
+
+// OnPush registers a handler for push events.
+func (wr *WebhookRouter) OnPush(fn func(*PushEvent)) {
+	wr.on(&PushEvent{}, func(e interface{}) { fn(e.(*PushEvent)) })
+}
+
+// OnRelease registers a handler for release events.
+func (wr *WebhookRouter) OnRelease(fn func(*ReleaseEvent)) {
+	wr.on(&ReleaseEvent{}, func(e interface{}) { fn(e.(*ReleaseEvent)) })
+}
+
+// OnSnippetComment registers a handler for comments on snippets.
+func (wr *WebhookRouter) OnSnippetComment(fn func(*SnippetCommentEvent)) {
+	wr.on(&SnippetCommentEvent{}, func(e interface{}) { fn(e.(*SnippetCommentEvent)) })
+}
+
+// OnTagPush registers a handler for tag push events.
+func (wr *WebhookRouter) OnTagPush(fn func(*TagEvent)) {
+	wr.on(&TagEvent{}, func(e interface{}) { fn(e.(*TagEvent)) })
+}
+
+// OnWikiPage registers a handler for wiki page events.
+func (wr *WebhookRouter) OnWikiPage(fn func(*WikiPageEvent)) {
+	wr.on(&WikiPageEvent{}, func(e interface{}) { fn(e.(*WikiPageEvent)) })
+}
+
+func (wr *WebhookRouter) on(event interface{}, fn func(interface{})) {
+	t := reflect.TypeOf(event)
+	wr.handlers[t] = append(wr.handlers[t], fn)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (wr *WebhookRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var h http.Handler = http.HandlerFunc(wr.handle)
+	for i := len(wr.middleware) - 1; i >= 0; i-- {
+		h = wr.middleware[i](h)
+	}
+	h.ServeHTTP(w, r)
+}
+
+func (wr *WebhookRouter) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if wr.secret != "" {
+		token := r.Header.Get(eventTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(wr.secret)) != 1 {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	eventType := WebhookEventType(r)
+
+	event, err := ParseWebhook(eventType, payload)
+	if err != nil {
+		if _, ok := err.(unknownEventTypeError); ok {
+			if wr.unknown != nil {
+				wr.unknown(eventType, payload)
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, fn := range wr.handlers[reflect.TypeOf(event)] {
+		fn(event)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newWebhookRequest(eventType EventType, token, payload string) *http.Request {
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(payload))
+	req.Header.Set(eventTypeHeader, string(eventType))
+	if token != "" {
+		req.Header.Set(eventTokenHeader, token)
+	}
+	return req
+}
+
+func TestWebhookRouterDispatch(t *testing.T) {
+	router := NewWebhookRouter("secret")
+
+	var pushes, pipelines int
+	router.OnPush(func(event *PushEvent) {
+		pushes++
+		if event.Ref != "refs/heads/master" {
+			t.Errorf("Ref is %v, want %v", event.Ref, "refs/heads/master")
+		}
+	})
+	router.OnPipeline(func(event *PipelineEvent) {
+		pipelines++
+	})
+
+	var order []string
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			order = append(order, "first")
+			next.ServeHTTP(w, r)
+		})
+	}, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			order = append(order, "second")
+			next.ServeHTTP(w, r)
+		})
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newWebhookRequest(EventTypePush, "secret", `{"object_kind": "push", "ref": "refs/heads/master"}`))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Status code is %d, want %d", w.Code, http.StatusOK)
+	}
+	if pushes != 1 || pipelines != 0 {
+		t.Errorf("Handled %d push and %d pipeline events, want 1 and 0", pushes, pipelines)
+	}
+	if strings.Join(order, ",") != "first,second" {
+		t.Errorf("Middleware order is %v, want [first second]", order)
+	}
+}
+
+func TestWebhookRouterInvalidToken(t *testing.T) {
+	router := NewWebhookRouter("secret")
+	router.OnPush(func(event *PushEvent) {
+		t.Error("Handler should not be called")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newWebhookRequest(EventTypePush, "wrong", `{"object_kind": "push"}`))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Status code is %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestWebhookRouterUnknownEvent(t *testing.T) {
+	router := NewWebhookRouter("")
+
+	var unknown EventType
+	router.OnUnknown(func(eventType EventType, payload []byte) {
+		unknown = eventType
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newWebhookRequest("Subgroup Hook", "", `{}`))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Status code is %d, want %d", w.Code, http.StatusOK)
+	}
+	if unknown != "Subgroup Hook" {
+		t.Errorf("Unknown event type is %v, want %v", unknown, "Subgroup Hook")
+	}
+}
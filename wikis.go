@@ -16,7 +16,6 @@ package gitlab
 
 import (
 	"fmt"
-	"net/url"
 )
 
 // WikisService handles communication with the wikis related methods of
@@ -71,7 +70,7 @@ func (s *WikisService) ListWikis(pid interface{}, opt *ListWikisOptions, options
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/wikis", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/wikis", pathEscape(project))
 
 	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
@@ -96,7 +95,7 @@ func (s *WikisService) GetWikiPage(pid interface{}, slug string, options ...Opti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/wikis/%s", url.QueryEscape(project), url.QueryEscape(slug))
+	u := fmt.Sprintf("projects/%s/wikis/%s", pathEscape(project), pathEscape(slug))
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
@@ -117,8 +116,8 @@ func (s *WikisService) GetWikiPage(pid interface{}, slug string, options ...Opti
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/wikis.html#create-a-new-wiki-page
 type CreateWikiPageOptions struct {
-	Content *string `url:"content" json:"content"`
-	Title   *string `url:"title" json:"title"`
+	Content *string `url:"content,omitempty" json:"content,omitempty"`
+	Title   *string `url:"title,omitempty" json:"title,omitempty"`
 	Format  *string `url:"format,omitempty" json:"format,omitempty"`
 }
 
@@ -132,7 +131,7 @@ func (s *WikisService) CreateWikiPage(pid interface{}, opt *CreateWikiPageOption
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/wikis", url.QueryEscape(project))
+	u := fmt.Sprintf("projects/%s/wikis", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
@@ -153,8 +152,8 @@ func (s *WikisService) CreateWikiPage(pid interface{}, opt *CreateWikiPageOption
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/wikis.html#edit-an-existing-wiki-page
 type EditWikiPageOptions struct {
-	Content *string `url:"content" json:"content"`
-	Title   *string `url:"title" json:"title"`
+	Content *string `url:"content,omitempty" json:"content,omitempty"`
+	Title   *string `url:"title,omitempty" json:"title,omitempty"`
 	Format  *string `url:"format,omitempty" json:"format,omitempty"`
 }
 
@@ -168,7 +167,7 @@ func (s *WikisService) EditWikiPage(pid interface{}, slug string, opt *EditWikiP
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/wikis/%s", url.QueryEscape(project), url.QueryEscape(slug))
+	u := fmt.Sprintf("projects/%s/wikis/%s", pathEscape(project), pathEscape(slug))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -193,7 +192,7 @@ func (s *WikisService) DeleteWikiPage(pid interface{}, slug string, options ...O
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/wikis/%s", url.QueryEscape(project), url.QueryEscape(slug))
+	u := fmt.Sprintf("projects/%s/wikis/%s", pathEscape(project), pathEscape(slug))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {